@@ -0,0 +1,90 @@
+package goshopify
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const appProxySignatureParam = "signature"
+
+type appProxyContextKey struct{}
+
+// AppProxyRequest holds the Shopify supplied parameters of a verified app
+// proxy request.
+// See: https://shopify.dev/apps/online-store/app-proxies
+type AppProxyRequest struct {
+	Shop               string
+	LoggedInCustomerID string
+	PathPrefix         string
+	Timestamp          string
+}
+
+// Verifies an app proxy http request, sent by Shopify.
+//
+// App proxy requests are signed differently from OAuth callbacks: the
+// signature is computed over the sorted parameters concatenated without any
+// separator, with the values of repeated parameters joined by commas.
+func (app App) VerifyAppProxyRequest(httpRequest *http.Request) bool {
+	q := httpRequest.URL.Query()
+	messageMAC := q.Get(appProxySignatureParam)
+	if messageMAC == "" {
+		return false
+	}
+
+	q.Del(appProxySignatureParam)
+
+	return app.VerifyMessage(appProxyMessage(q), messageMAC)
+}
+
+// appProxyMessage builds the message an app proxy signature is computed over.
+func appProxyMessage(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var message strings.Builder
+	for _, k := range keys {
+		message.WriteString(k)
+		message.WriteString("=")
+		message.WriteString(strings.Join(q[k], ","))
+	}
+
+	return message.String()
+}
+
+// AppProxyMiddleware returns an http.Handler that only passes app proxy
+// requests with a valid signature on to next. Requests that fail verification
+// are answered with a 401 Unauthorized.
+// The verified shop, customer and path prefix are available to next through
+// AppProxyRequestFromContext.
+func (app App) AppProxyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !app.VerifyAppProxyRequest(r) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		q := r.URL.Query()
+		proxyRequest := &AppProxyRequest{
+			Shop:               q.Get("shop"),
+			LoggedInCustomerID: q.Get("logged_in_customer_id"),
+			PathPrefix:         q.Get("path_prefix"),
+			Timestamp:          q.Get("timestamp"),
+		}
+
+		ctx := context.WithValue(r.Context(), appProxyContextKey{}, proxyRequest)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// AppProxyRequestFromContext returns the verified app proxy parameters stored
+// by AppProxyMiddleware, if any.
+func AppProxyRequestFromContext(ctx context.Context) (*AppProxyRequest, bool) {
+	proxyRequest, ok := ctx.Value(appProxyContextKey{}).(*AppProxyRequest)
+	return proxyRequest, ok
+}
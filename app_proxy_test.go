@@ -0,0 +1,74 @@
+package goshopify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// This example is from the Shopify app proxy documentation:
+// https://shopify.dev/apps/online-store/app-proxies#calculate-a-digital-signature
+const appProxyQuery = "extra=1&extra=2&shop=shop-name.myshopify.com&logged_in_customer_id=1&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555"
+
+func TestAppVerifyAppProxyRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		query    string
+		expected bool
+	}{
+		{appProxyQuery + "&signature=4c68c8624d737112c91818c11017d24d334b524cb5c2b8ba08daa056f7395ddb", true},
+		{appProxyQuery + "&signature=4c68c8624d737112c91818c11017d24d334b524cb5c2b8ba08daa056f7395ddc", false},
+		{"extra=2&extra=1&shop=shop-name.myshopify.com&logged_in_customer_id=1&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555&signature=4c68c8624d737112c91818c11017d24d334b524cb5c2b8ba08daa056f7395ddb", false},
+		{appProxyQuery, false},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest("GET", "https://example.com/proxy?"+c.query, nil)
+		actual := app.VerifyAppProxyRequest(req)
+		if actual != c.expected {
+			t.Errorf("App.VerifyAppProxyRequest(%s): expected %v, actual %v", c.query, c.expected, actual)
+		}
+	}
+}
+
+func TestAppProxyMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var proxyRequest *AppProxyRequest
+	handler := app.AppProxyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxyRequest, _ = AppProxyRequestFromContext(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "https://example.com/proxy?"+appProxyQuery+"&signature=4c68c8624d737112c91818c11017d24d334b524cb5c2b8ba08daa056f7395ddb", nil)
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("AppProxyMiddleware returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	expected := AppProxyRequest{
+		Shop:               "shop-name.myshopify.com",
+		LoggedInCustomerID: "1",
+		PathPrefix:         "/apps/awesome_reviews",
+		Timestamp:          "1317327555",
+	}
+	if proxyRequest == nil || *proxyRequest != expected {
+		t.Errorf("AppProxyRequestFromContext returned %+v, expected %+v", proxyRequest, expected)
+	}
+
+	proxyRequest = nil
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "https://example.com/proxy?"+appProxyQuery+"&signature=bad", nil)
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("AppProxyMiddleware returned status %d, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+	if proxyRequest != nil {
+		t.Errorf("AppProxyMiddleware called next handler for an invalid signature")
+	}
+}
@@ -3,8 +3,6 @@ package goshopify
 import (
 	"fmt"
 	"time"
-
-	"github.com/shopspring/decimal"
 )
 
 const applicationChargesBasePath = "application_charges"
@@ -24,18 +22,18 @@ type ApplicationChargeServiceOp struct {
 }
 
 type ApplicationCharge struct {
-	ID                 int64            `json:"id"`
-	Name               string           `json:"name"`
-	APIClientID        int64            `json:"api_client_id"`
-	Price              *decimal.Decimal `json:"price"`
-	Status             string           `json:"status"`
-	ReturnURL          string           `json:"return_url"`
-	Test               *bool            `json:"test"`
-	CreatedAt          *time.Time       `json:"created_at"`
-	UpdatedAt          *time.Time       `json:"updated_at"`
-	ChargeType         *string          `json:"charge_type"`
-	DecoratedReturnURL string           `json:"decorated_return_url"`
-	ConfirmationURL    string           `json:"confirmation_url"`
+	ID                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	APIClientID        int64      `json:"api_client_id"`
+	Price              *Money     `json:"price"`
+	Status             string     `json:"status"`
+	ReturnURL          string     `json:"return_url"`
+	Test               *bool      `json:"test"`
+	CreatedAt          *time.Time `json:"created_at"`
+	UpdatedAt          *time.Time `json:"updated_at"`
+	ChargeType         *string    `json:"charge_type"`
+	DecoratedReturnURL string     `json:"decorated_return_url"`
+	ConfirmationURL    string     `json:"confirmation_url"`
 }
 
 // ApplicationChargeResource represents the result from the
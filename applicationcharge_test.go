@@ -59,7 +59,7 @@ func TestApplicationChargeServiceOp_Create(t *testing.T) {
 	p := decimal.NewFromFloat(100.00)
 	charge := ApplicationCharge{
 		Name:      "Super Duper Expensive action",
-		Price:     &p,
+		Price:     NewMoney(p, ""),
 		ReturnURL: "http://super-duper.shopifyapps.com",
 	}
 
@@ -0,0 +1,170 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const carrierServicesBasePath = "carrier_services"
+
+// Types of carrier services
+const (
+	CarrierServiceTypeAPI    = "api"
+	CarrierServiceTypeLegacy = "legacy"
+)
+
+// CarrierServiceService is an interface for interfacing with the carrier
+// service endpoints of the Shopify API, used to provide shipping rates at
+// checkout. See CarrierServiceHandler for answering the rate requests.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shipping-and-fulfillment/carrierservice
+type CarrierServiceService interface {
+	List() ([]CarrierService, error)
+	Get(int64) (*CarrierService, error)
+	Create(CarrierService) (*CarrierService, error)
+	Update(CarrierService) (*CarrierService, error)
+	Delete(int64) error
+}
+
+// CarrierServiceServiceOp handles communication with the carrier service related
+// methods of the Shopify API.
+type CarrierServiceServiceOp struct {
+	client *Client
+}
+
+// CarrierService represents a Shopify carrier service
+type CarrierService struct {
+	ID                 int64  `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Active             *bool  `json:"active,omitempty"`
+	ServiceDiscovery   *bool  `json:"service_discovery,omitempty"`
+	CarrierServiceType string `json:"carrier_service_type,omitempty"`
+	CallbackURL        string `json:"callback_url,omitempty"`
+	Format             string `json:"format,omitempty"`
+	AdminGraphqlAPIID  string `json:"admin_graphql_api_id,omitempty"`
+}
+
+// CarrierServiceResource represents the result from the carrier_services/X.json endpoint
+type CarrierServiceResource struct {
+	CarrierService *CarrierService `json:"carrier_service"`
+}
+
+// CarrierServicesResource represents the result from the carrier_services.json endpoint
+type CarrierServicesResource struct {
+	CarrierServices []CarrierService `json:"carrier_services"`
+}
+
+// ShippingRateRequest is sent by Shopify to the callback URL of a carrier
+// service to get shipping rates for a cart.
+type ShippingRateRequest struct {
+	Origin      ShippingRateAddress `json:"origin"`
+	Destination ShippingRateAddress `json:"destination"`
+	Items       []ShippingRateItem  `json:"items"`
+	Currency    string              `json:"currency"`
+	Locale      string              `json:"locale"`
+}
+
+// ShippingRateAddress is the origin or destination of a shipping rate request
+type ShippingRateAddress struct {
+	Country     string `json:"country"`
+	PostalCode  string `json:"postal_code"`
+	Province    string `json:"province"`
+	City        string `json:"city"`
+	Name        string `json:"name"`
+	Address1    string `json:"address1"`
+	Address2    string `json:"address2"`
+	Address3    string `json:"address3"`
+	Phone       string `json:"phone"`
+	Fax         string `json:"fax"`
+	Email       string `json:"email"`
+	AddressType string `json:"address_type"`
+	CompanyName string `json:"company_name"`
+}
+
+// ShippingRateItem is an item of a shipping rate request. Price is in the
+// smallest unit of the currency, e.g. cents.
+type ShippingRateItem struct {
+	Name               string            `json:"name"`
+	SKU                string            `json:"sku"`
+	Quantity           int               `json:"quantity"`
+	Grams              int               `json:"grams"`
+	Price              int64             `json:"price"`
+	Vendor             string            `json:"vendor"`
+	RequiresShipping   bool              `json:"requires_shipping"`
+	Taxable            bool              `json:"taxable"`
+	FulfillmentService string            `json:"fulfillment_service"`
+	Properties         map[string]string `json:"properties"`
+	ProductID          int64             `json:"product_id"`
+	VariantID          int64             `json:"variant_id"`
+}
+
+// ShippingRate is a rate returned by a carrier service. TotalPrice is in the
+// smallest unit of the currency, e.g. cents.
+type ShippingRate struct {
+	ServiceName     string     `json:"service_name"`
+	ServiceCode     string     `json:"service_code"`
+	TotalPrice      int64      `json:"total_price,string"`
+	Description     string     `json:"description,omitempty"`
+	Currency        string     `json:"currency"`
+	MinDeliveryDate *time.Time `json:"-"`
+	MaxDeliveryDate *time.Time `json:"-"`
+}
+
+// shippingRateDateFormat is the format of delivery dates in rate responses
+const shippingRateDateFormat = "2006-01-02 15:04:05 -0700"
+
+// MarshalJSON formats the delivery dates the way Shopify expects them.
+func (r ShippingRate) MarshalJSON() ([]byte, error) {
+	type alias ShippingRate
+	aux := struct {
+		alias
+		MinDeliveryDate string `json:"min_delivery_date,omitempty"`
+		MaxDeliveryDate string `json:"max_delivery_date,omitempty"`
+	}{alias: alias(r)}
+	if r.MinDeliveryDate != nil {
+		aux.MinDeliveryDate = r.MinDeliveryDate.Format(shippingRateDateFormat)
+	}
+	if r.MaxDeliveryDate != nil {
+		aux.MaxDeliveryDate = r.MaxDeliveryDate.Format(shippingRateDateFormat)
+	}
+	return json.Marshal(aux)
+}
+
+// List carrier services
+func (s *CarrierServiceServiceOp) List() ([]CarrierService, error) {
+	path := fmt.Sprintf("%s.json", carrierServicesBasePath)
+	resource := new(CarrierServicesResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.CarrierServices, err
+}
+
+// Get individual carrier service
+func (s *CarrierServiceServiceOp) Get(carrierServiceID int64) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierServiceID)
+	resource := new(CarrierServiceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.CarrierService, err
+}
+
+// Create a new carrier service
+func (s *CarrierServiceServiceOp) Create(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s.json", carrierServicesBasePath)
+	wrappedData := CarrierServiceResource{CarrierService: &carrierService}
+	resource := new(CarrierServiceResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Update an existing carrier service
+func (s *CarrierServiceServiceOp) Update(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierService.ID)
+	wrappedData := CarrierServiceResource{CarrierService: &carrierService}
+	resource := new(CarrierServiceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Delete an existing carrier service
+func (s *CarrierServiceServiceOp) Delete(carrierServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierServiceID))
+}
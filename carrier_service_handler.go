@@ -0,0 +1,124 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// defaultRateTimeout stays below the time Shopify waits for a carrier service
+// before falling back to backup rates.
+const defaultRateTimeout = 5 * time.Second
+
+// RateProvider is implemented by carrier service apps to compute shipping
+// rates. The context is cancelled once the deadline of the handler passes.
+type RateProvider interface {
+	Rates(ctx context.Context, shop string, request ShippingRateRequest) ([]ShippingRate, error)
+}
+
+// RateProviderFunc is an adapter to use a function as a RateProvider.
+type RateProviderFunc func(ctx context.Context, shop string, request ShippingRateRequest) ([]ShippingRate, error)
+
+// Rates calls f.
+func (f RateProviderFunc) Rates(ctx context.Context, shop string, request ShippingRateRequest) ([]ShippingRate, error) {
+	return f(ctx, shop, request)
+}
+
+// CarrierServiceHandler is an http.Handler answering the rate requests Shopify
+// sends to the CallbackURL of a carrier service. Requests are verified with the
+// app secret. When the RateProvider fails or misses the deadline the handler
+// answers with an error so that Shopify uses its backup rates.
+type CarrierServiceHandler struct {
+	app      App
+	provider RateProvider
+
+	// Timeout is the deadline for the RateProvider, defaults to 5 seconds.
+	Timeout time.Duration
+}
+
+// NewCarrierServiceHandler returns a CarrierServiceHandler delegating to the
+// provider.
+func NewCarrierServiceHandler(app App, provider RateProvider) *CarrierServiceHandler {
+	return &CarrierServiceHandler{app: app, provider: provider, Timeout: defaultRateTimeout}
+}
+
+type shippingRateRequestResource struct {
+	Rate ShippingRateRequest `json:"rate"`
+}
+
+type shippingRatesResource struct {
+	Rates []ShippingRate `json:"rates"`
+}
+
+type rateResult struct {
+	rates []ShippingRate
+	err   error
+}
+
+// ServeHTTP answers a rate request.
+func (h *CarrierServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+
+	if ok, _ := h.app.VerifyWebhookRequestVerbose(r); !ok {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	resource := new(shippingRateRequestResource)
+	if err := json.NewDecoder(r.Body).Decode(resource); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	request := resource.Rate
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = defaultRateTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	results := make(chan rateResult, 1)
+	go func() {
+		rates, err := h.provider.Rates(ctx, callbackShop(r), request)
+		results <- rateResult{rates, err}
+	}()
+
+	var result rateResult
+	select {
+	case result = <-results:
+	case <-ctx.Done():
+		result.err = ctx.Err()
+	}
+
+	if result.err == context.DeadlineExceeded {
+		http.Error(w, http.StatusText(http.StatusGatewayTimeout), http.StatusGatewayTimeout)
+		return
+	}
+	if result.err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, shippingRatesResource{Rates: validShippingRates(result.rates, request.Currency)})
+}
+
+// validShippingRates drops the rates Shopify would reject, without a name or
+// code or with a negative price, and defaults their currency to the one of the
+// request.
+func validShippingRates(rates []ShippingRate, currency string) []ShippingRate {
+	valid := []ShippingRate{}
+	for _, rate := range rates {
+		if rate.ServiceName == "" || rate.ServiceCode == "" || rate.TotalPrice < 0 {
+			continue
+		}
+		if rate.Currency == "" {
+			rate.Currency = currency
+		}
+		valid = append(valid, rate)
+	}
+	return valid
+}
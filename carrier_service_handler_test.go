@@ -0,0 +1,91 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newRateRequest(body []byte) *http.Request {
+	req := httptest.NewRequest("POST", "https://example.com/rates", bytes.NewBuffer(body))
+	req.Header.Set("X-Shopify-Hmac-Sha256", app.SignWebhookBody(body))
+	req.Header.Set("X-Shopify-Shop-Domain", "fooshop.myshopify.com")
+	return req
+}
+
+func TestCarrierServiceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var shop string
+	var request ShippingRateRequest
+	handler := NewCarrierServiceHandler(app, RateProviderFunc(func(ctx context.Context, s string, r ShippingRateRequest) ([]ShippingRate, error) {
+		shop, request = s, r
+		return []ShippingRate{
+			{ServiceName: "canadapost-overnight", ServiceCode: "ON", TotalPrice: 1295},
+			{ServiceName: "missing-code", TotalPrice: 100},
+			{ServiceName: "negative", ServiceCode: "NEG", TotalPrice: -1},
+			{ServiceName: "canadapost-2dayground", ServiceCode: "2D", TotalPrice: 2934, Currency: "CAD"},
+		}, nil
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRateRequest(loadFixture("shipping_rate_request.json")))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("CarrierServiceHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	if shop != "fooshop.myshopify.com" || len(request.Items) != 1 || request.Items[0].Grams != 1000 {
+		t.Errorf("CarrierServiceHandler called the provider with %s and %+v", shop, request)
+	}
+
+	expectedBody := `{"rates":[{"service_name":"canadapost-overnight","service_code":"ON","total_price":"1295","currency":"USD"},` +
+		`{"service_name":"canadapost-2dayground","service_code":"2D","total_price":"2934","currency":"CAD"}]}`
+	if body := strings.TrimSpace(rec.Body.String()); body != expectedBody {
+		t.Errorf("CarrierServiceHandler returned %s, expected %s", body, expectedBody)
+	}
+}
+
+func TestCarrierServiceHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := loadFixture("shipping_rate_request.json")
+
+	cases := []struct {
+		description string
+		request     *http.Request
+		provider    RateProviderFunc
+		status      int
+	}{
+		{"unsigned", httptest.NewRequest("POST", "https://example.com/rates", bytes.NewBuffer(body)), nil, http.StatusUnauthorized},
+		{"wrong method", httptest.NewRequest("GET", "https://example.com/rates", nil), nil, http.StatusMethodNotAllowed},
+		{"invalid json", newRateRequest([]byte(`{"rate":`)), nil, http.StatusBadRequest},
+		{"provider error", newRateRequest(body), func(ctx context.Context, shop string, r ShippingRateRequest) ([]ShippingRate, error) {
+			return nil, errors.New("test-error")
+		}, http.StatusInternalServerError},
+		{"deadline", newRateRequest(body), func(ctx context.Context, shop string, r ShippingRateRequest) ([]ShippingRate, error) {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			return []ShippingRate{{ServiceName: "late", ServiceCode: "L"}}, nil
+		}, http.StatusGatewayTimeout},
+	}
+
+	for _, c := range cases {
+		handler := NewCarrierServiceHandler(app, c.provider)
+		handler.Timeout = 10 * time.Millisecond
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.request)
+
+		if rec.Code != c.status {
+			t.Errorf("CarrierServiceHandler (%s) returned status %d, expected %d", c.description, rec.Code, c.status)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func carrierServiceTests(t *testing.T, carrierService *CarrierService) {
+	if carrierService == nil {
+		t.Fatal("CarrierService is nil")
+	}
+
+	active := true
+	expected := &CarrierService{
+		ID:                 1036894958,
+		Name:               "Purolator",
+		Active:             &active,
+		ServiceDiscovery:   &active,
+		CarrierServiceType: CarrierServiceTypeAPI,
+		CallbackURL:        "http://myapp.com/rates",
+		Format:             "json",
+		AdminGraphqlAPIID:  "gid://shopify/DeliveryCarrierService/1036894958",
+	}
+	if !reflect.DeepEqual(carrierService, expected) {
+		t.Errorf("CarrierService returned %+v, expected %+v", carrierService, expected)
+	}
+}
+
+func TestCarrierServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_services.json")))
+
+	carrierServices, err := client.CarrierService.List()
+	if err != nil {
+		t.Errorf("CarrierService.List returned error: %v", err)
+	}
+
+	if len(carrierServices) != 2 || carrierServices[1].CarrierServiceType != CarrierServiceTypeLegacy {
+		t.Fatalf("CarrierService.List returned %+v", carrierServices)
+	}
+	carrierServiceTests(t, &carrierServices[0])
+}
+
+func TestCarrierServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894958.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_service.json")))
+
+	carrierService, err := client.CarrierService.Get(1036894958)
+	if err != nil {
+		t.Errorf("CarrierService.Get returned error: %v", err)
+	}
+
+	carrierServiceTests(t, carrierService)
+}
+
+func TestCarrierServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("carrier_service.json")), nil
+		})
+
+	serviceDiscovery := true
+	carrierService, err := client.CarrierService.Create(CarrierService{
+		Name:             "Purolator",
+		CallbackURL:      "http://myapp.com/rates",
+		ServiceDiscovery: &serviceDiscovery,
+	})
+	if err != nil {
+		t.Errorf("CarrierService.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"carrier_service":{"name":"Purolator","service_discovery":true,"callback_url":"http://myapp.com/rates"}}`
+	if body != expectedBody {
+		t.Errorf("CarrierService.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	carrierServiceTests(t, carrierService)
+}
+
+func TestCarrierServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894958.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_service.json")))
+
+	carrierService, err := client.CarrierService.Update(CarrierService{ID: 1036894958, Name: "Purolator"})
+	if err != nil {
+		t.Errorf("CarrierService.Update returned error: %v", err)
+	}
+
+	carrierServiceTests(t, carrierService)
+}
+
+func TestCarrierServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894958.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CarrierService.Delete(1036894958)
+	if err != nil {
+		t.Errorf("CarrierService.Delete returned error: %v", err)
+	}
+}
+
+func TestShippingRateRequestDecode(t *testing.T) {
+	resource := new(shippingRateRequestResource)
+	if err := json.Unmarshal(loadFixture("shipping_rate_request.json"), resource); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	request := resource.Rate
+	if request.Origin.PostalCode != "K2P1L4" || request.Destination.Name != "Bob Norman" || request.Currency != "USD" {
+		t.Errorf("ShippingRateRequest decoded as %+v", request)
+	}
+
+	expectedItem := ShippingRateItem{
+		Name:               "Short Sleeve T-Shirt",
+		Quantity:           1,
+		Grams:              1000,
+		Price:              1999,
+		Vendor:             "Jamie D's Emporium",
+		RequiresShipping:   true,
+		Taxable:            true,
+		FulfillmentService: "manual",
+		ProductID:          48447225880,
+		VariantID:          258644705304,
+	}
+	if len(request.Items) != 1 || !reflect.DeepEqual(request.Items[0], expectedItem) {
+		t.Errorf("ShippingRateRequest.Items decoded as %+v, expected %+v", request.Items, expectedItem)
+	}
+}
+
+func TestShippingRateMarshalJSON(t *testing.T) {
+	minDelivery := time.Date(2013, time.April, 12, 14, 48, 45, 0, time.FixedZone("", -4*60*60))
+	maxDelivery := minDelivery.AddDate(0, 0, 2)
+
+	cases := []struct {
+		rate     ShippingRate
+		expected string
+	}{
+		{
+			ShippingRate{
+				ServiceName:     "canadapost-overnight",
+				ServiceCode:     "ON",
+				TotalPrice:      1295,
+				Description:     "This is the fastest option by far",
+				Currency:        "CAD",
+				MinDeliveryDate: &minDelivery,
+				MaxDeliveryDate: &maxDelivery,
+			},
+			`{"service_name":"canadapost-overnight","service_code":"ON","total_price":"1295","description":"This is the fastest option by far","currency":"CAD","min_delivery_date":"2013-04-12 14:48:45 -0400","max_delivery_date":"2013-04-14 14:48:45 -0400"}`,
+		},
+		{
+			ShippingRate{ServiceName: "fedex-2dayground", ServiceCode: "2D", TotalPrice: 2934, Currency: "USD"},
+			`{"service_name":"fedex-2dayground","service_code":"2D","total_price":"2934","currency":"USD"}`,
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := json.Marshal(c.rate)
+		if err != nil {
+			t.Errorf("ShippingRate.MarshalJSON returned error: %v", err)
+		}
+		if string(actual) != c.expected {
+			t.Errorf("ShippingRate.MarshalJSON returned %s, expected %s", actual, c.expected)
+		}
+	}
+}
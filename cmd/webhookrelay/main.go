@@ -0,0 +1,304 @@
+// Command webhookrelay sends signed Shopify webhooks to a local handler, so
+// that webhook handlers can be developed and tested offline.
+//
+// Usage:
+//
+//	webhookrelay send -secret hush -url http://localhost:8080/webhooks -topic orders/create -payload fixtures/order.json
+//	webhookrelay capture -addr :8081 -dir deliveries -forward http://localhost:8080/webhooks
+//	webhookrelay replay -secret hush -url http://localhost:8080/webhooks deliveries/1591881600000000000.json
+//
+// send signs a JSON payload with the app secret exactly like Shopify does and
+// POSTs it with realistic X-Shopify-* headers. Payloads wrapped in a single
+// root object, like the fixtures of this repository ({"order": {...}}), are
+// unwrapped first since Shopify delivers the bare resource.
+//
+// capture listens for webhooks, stores every delivery as a JSON file and
+// optionally forwards it to a handler. replay sends a captured delivery again
+// with its original headers, re-signing it when a secret is given.
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	goshopify "github.com/irahulranjan/go-shopify/v3"
+)
+
+const usage = `usage: webhookrelay <command> [flags]
+
+commands:
+  send     sign a JSON payload and POST it as a webhook
+  capture  listen for webhooks and store (and forward) every delivery
+  replay   POST a captured delivery again
+
+Run "webhookrelay <command> -h" for the flags of a command.
+`
+
+// Delivery is a webhook delivery as stored by the capture command.
+type Delivery struct {
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+// defaultTopics guesses the topic of the repository fixtures.
+var defaultTopics = map[string]string{
+	"customer":    "customers/create",
+	"draft_order": "draft_orders/create",
+	"fulfillment": "fulfillments/create",
+	"location":    "locations/create",
+	"order":       "orders/create",
+	"product":     "products/create",
+	"shop":        "shop/update",
+	"theme":       "themes/update",
+	"transaction": "order_transactions/create",
+}
+
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "send":
+		err = send(os.Args[2:])
+	case "capture":
+		err = capture(os.Args[2:])
+	case "replay":
+		err = replay(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func send(args []string) error {
+	flags := flag.NewFlagSet("send", flag.ExitOnError)
+	secret := flags.String("secret", os.Getenv("SHOPIFY_API_SECRET"), "app secret used to sign the payload, defaults to $SHOPIFY_API_SECRET")
+	target := flags.String("url", "http://localhost:8080/webhooks", "URL of the webhook handler")
+	payload := flags.String("payload", "", "path of the JSON payload, e.g. fixtures/order.json")
+	topic := flags.String("topic", "", "webhook topic, guessed from the payload root when empty")
+	shop := flags.String("shop", "fooshop.myshopify.com", "shop domain")
+	apiVersion := flags.String("api-version", "2020-01", "API version of the payload")
+	flags.Parse(args)
+
+	if *payload == "" {
+		return errors.New("send: -payload is required")
+	}
+
+	data, err := ioutil.ReadFile(*payload)
+	if err != nil {
+		return err
+	}
+
+	body, root, err := unwrapPayload(data)
+	if err != nil {
+		return fmt.Errorf("send: %s: %v", *payload, err)
+	}
+
+	if *topic == "" {
+		*topic = defaultTopics[root]
+		if *topic == "" {
+			return errors.New("send: -topic is required for this payload")
+		}
+	}
+
+	delivery := NewDelivery(*topic, *shop, *apiVersion, body, time.Now())
+	return post(*target, delivery, goshopify.App{ApiSecret: *secret})
+}
+
+func replay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	secret := flags.String("secret", os.Getenv("SHOPIFY_API_SECRET"), "app secret used to re-sign the delivery, keeps the captured HMAC when empty")
+	target := flags.String("url", "http://localhost:8080/webhooks", "URL of the webhook handler")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		return errors.New("replay: captured delivery files are required")
+	}
+
+	for _, file := range flags.Args() {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		delivery := new(Delivery)
+		if err := json.Unmarshal(data, delivery); err != nil {
+			return fmt.Errorf("replay: %s: %v", file, err)
+		}
+
+		if err := post(*target, delivery, goshopify.App{ApiSecret: *secret}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func capture(args []string) error {
+	flags := flag.NewFlagSet("capture", flag.ExitOnError)
+	addr := flags.String("addr", ":8081", "address to listen on")
+	dir := flags.String("dir", "deliveries", "directory to store the deliveries in")
+	forward := flags.String("forward", "", "URL of a webhook handler to forward deliveries to")
+	flags.Parse(args)
+
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		return err
+	}
+
+	log.Printf("capturing webhooks on %s into %s", *addr, *dir)
+	return http.ListenAndServe(*addr, CaptureHandler(*dir, *forward))
+}
+
+// CaptureHandler stores every request it receives as a Delivery file in dir
+// and, if forward is not empty, passes it on unchanged.
+func CaptureHandler(dir, forward string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		delivery := &Delivery{Headers: map[string]string{}, Body: string(body)}
+		for name := range r.Header {
+			if strings.HasPrefix(name, "X-Shopify-") || name == "Content-Type" {
+				delivery.Headers[name] = r.Header.Get(name)
+			}
+		}
+
+		data, _ := json.MarshalIndent(delivery, "", "  ")
+		file := filepath.Join(dir, strconv.FormatInt(time.Now().UnixNano(), 10)+".json")
+		if err := ioutil.WriteFile(file, data, 0644); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		log.Printf("captured %s from %s into %s", delivery.Headers["X-Shopify-Topic"], delivery.Headers["X-Shopify-Shop-Domain"], file)
+
+		if forward == "" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		req, err := newRequest(forward, delivery)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		w.WriteHeader(resp.StatusCode)
+	})
+}
+
+// NewDelivery returns a delivery for the body with the headers Shopify sends.
+func NewDelivery(topic, shop, apiVersion string, body []byte, triggeredAt time.Time) *Delivery {
+	return &Delivery{
+		Headers: map[string]string{
+			"Content-Type":           "application/json",
+			"X-Shopify-Topic":        topic,
+			"X-Shopify-Shop-Domain":  goshopify.ShopFullName(shop),
+			"X-Shopify-Api-Version":  apiVersion,
+			"X-Shopify-Webhook-Id":   newUUID(),
+			"X-Shopify-Event-Id":     newUUID(),
+			"X-Shopify-Triggered-At": triggeredAt.UTC().Format(time.RFC3339Nano),
+		},
+		Body: string(body),
+	}
+}
+
+// Sign sets the X-Shopify-Hmac-Sha256 header of the delivery.
+func (d *Delivery) Sign(app goshopify.App) {
+	d.Headers["X-Shopify-Hmac-Sha256"] = app.SignWebhookBody([]byte(d.Body))
+}
+
+// unwrapPayload returns the resource of payloads wrapped in a single root
+// object, along with the root name. Other payloads are returned unchanged.
+func unwrapPayload(data []byte) ([]byte, string, error) {
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, "", err
+	}
+
+	if len(root) == 1 {
+		for name, resource := range root {
+			if len(resource) > 0 && resource[0] == '{' {
+				return resource, name, nil
+			}
+		}
+	}
+
+	return data, "", nil
+}
+
+func post(target string, delivery *Delivery, app goshopify.App) error {
+	if app.ApiSecret != "" {
+		delivery.Sign(app)
+	}
+	if delivery.Headers["X-Shopify-Hmac-Sha256"] == "" {
+		return errors.New("an app secret is required to sign the delivery")
+	}
+
+	req, err := newRequest(target, delivery)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s: %s", delivery.Headers["X-Shopify-Topic"], delivery.Headers["X-Shopify-Webhook-Id"], resp.Status)
+	}
+	log.Printf("%s %s: %s", delivery.Headers["X-Shopify-Topic"], delivery.Headers["X-Shopify-Webhook-Id"], resp.Status)
+	return nil
+}
+
+func newRequest(target string, delivery *Delivery) (*http.Request, error) {
+	req, err := http.NewRequest("POST", target, bytes.NewBufferString(delivery.Body))
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range delivery.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("User-Agent", "Shopify-Captain-Hook")
+	return req, nil
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	goshopify "github.com/irahulranjan/go-shopify/v3"
+)
+
+func TestUnwrapPayload(t *testing.T) {
+	data, err := ioutil.ReadFile("../../fixtures/order.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, root, err := unwrapPayload(data)
+	if err != nil {
+		t.Fatalf("unwrapPayload returned error: %v", err)
+	}
+	if root != "order" || defaultTopics[root] != "orders/create" {
+		t.Errorf("unwrapPayload returned root %s, expected order", root)
+	}
+	if body[0] != '{' || string(body[:8]) != `{"id":12` {
+		t.Errorf("unwrapPayload returned body %.20s, expected the bare order", body)
+	}
+
+	body, root, _ = unwrapPayload([]byte(`{"id":1,"name":"#1001"}`))
+	if root != "" || string(body) != `{"id":1,"name":"#1001"}` {
+		t.Errorf("unwrapPayload changed a bare payload: %s %s", root, body)
+	}
+}
+
+func TestSendAndReplay(t *testing.T) {
+	app := goshopify.App{ApiSecret: "hush"}
+
+	var orders []*goshopify.Order
+	var deliveries []*goshopify.WebhookDelivery
+	handler := goshopify.NewWebhookHandler(app)
+	handler.HandleOrder(func(d *goshopify.WebhookDelivery, o *goshopify.Order) error {
+		deliveries = append(deliveries, d)
+		orders = append(orders, o)
+		return nil
+	}, goshopify.WebhookTopicOrdersCreate)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "webhookrelay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	capture := httptest.NewServer(CaptureHandler(dir, server.URL))
+	defer capture.Close()
+
+	err = send([]string{"-secret", "hush", "-url", capture.URL, "-payload", "../../fixtures/order.json", "-shop", "fooshop"})
+	if err != nil {
+		t.Fatalf("send returned error: %v", err)
+	}
+	if len(orders) != 1 || orders[0].ID != 123456 {
+		t.Fatalf("send delivered orders %+v, expected order 123456", orders)
+	}
+
+	d := deliveries[0]
+	if d.ShopDomain != "fooshop.myshopify.com" || d.WebhookID == "" || d.EventID == "" || d.APIVersion != "2020-01" ||
+		d.TriggeredAt == nil || time.Since(*d.TriggeredAt) > time.Minute {
+		t.Errorf("send delivered headers %+v", d)
+	}
+
+	captured, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(captured) != 1 {
+		t.Fatalf("capture stored %d deliveries, expected 1", len(captured))
+	}
+
+	// without a secret the captured signature is kept
+	err = replay(append([]string{"-secret", "", "-url", server.URL}, captured...))
+	if err != nil {
+		t.Fatalf("replay returned error: %v", err)
+	}
+	if len(orders) != 2 || orders[1].ID != 123456 || deliveries[1].WebhookID != d.WebhookID {
+		t.Errorf("replay delivered %+v, expected the captured delivery", deliveries[1])
+	}
+
+	// a delivery the handler rejects is an error
+	err = replay(append([]string{"-secret", "wrong", "-url", server.URL}, captured...))
+	if err == nil || len(orders) != 2 {
+		t.Errorf("replay with the wrong secret returned %v, expected an error", err)
+	}
+}
@@ -5,6 +5,7 @@ package goshopify
 // See: https://help.shopify.com/api/reference/shop
 type CurrencyService interface {
 	Get(options interface{}) ([]Currency, error)
+	IsEnabled(string) (bool, error)
 }
 
 // CurrencyServiceOp handles communication with the shop related methods of the
@@ -30,3 +31,18 @@ func (s *CurrencyServiceOp) Get(options interface{}) ([]Currency, error) {
 	err := s.client.Get("currencies.json", resource, options)
 	return resource.Currencies, err
 }
+
+// IsEnabled returns whether the currency is enabled on the shop
+func (s *CurrencyServiceOp) IsEnabled(currencyCode string) (bool, error) {
+	currencies, err := s.Get(nil)
+	if err != nil {
+		return false, err
+	}
+
+	for _, currency := range currencies {
+		if currency.Currency == currencyCode {
+			return currency.Enabled, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,44 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCurrencyGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/currencies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("currencies.json")))
+
+	currencies, err := client.Currency.Get(nil)
+	if err != nil {
+		t.Errorf("Currency.Get returned error: %v", err)
+	}
+
+	if len(currencies) != 3 || currencies[0].Currency != "CAD" || !currencies[0].Enabled || currencies[2].Enabled {
+		t.Errorf("Currency.Get returned %+v", currencies)
+	}
+}
+
+func TestCurrencyIsEnabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/currencies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("currencies.json")))
+
+	cases := map[string]bool{"CAD": true, "JPY": false, "GBP": false}
+	for currency, expected := range cases {
+		enabled, err := client.Currency.IsEnabled(currency)
+		if err != nil {
+			t.Errorf("Currency.IsEnabled(%s) returned error: %v", currency, err)
+		}
+		if enabled != expected {
+			t.Errorf("Currency.IsEnabled(%s) returned %v, expected %v", currency, enabled, expected)
+		}
+	}
+}
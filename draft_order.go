@@ -3,8 +3,6 @@ package goshopify
 import (
 	"fmt"
 	"time"
-
-	"github.com/shopspring/decimal"
 )
 
 const (
@@ -55,9 +53,9 @@ type DraftOrder struct {
 	TaxLines        []TaxLine        `json:"tax_lines,omitempty"`
 	AppliedDiscount *AppliedDiscount `json:"applied_discount,omitempty"`
 	TaxesIncluded   bool             `json:"taxes_included,omitempty"`
-	TotalTax        string           `json:"total_tax,omitempty"`
-	TotalPrice      string           `json:"total_price,omitempty"`
-	SubtotalPrice   *decimal.Decimal `json:"subtotal_price,omitempty"`
+	TotalTax        *Money           `json:"total_tax,omitempty"`
+	TotalPrice      *Money           `json:"total_price,omitempty"`
+	SubtotalPrice   *Money           `json:"subtotal_price,omitempty"`
 	CompletedAt     *time.Time       `json:"completed_at,omitempty"`
 	CreatedAt       *time.Time       `json:"created_at,omitempty"`
 	UpdatedAt       *time.Time       `json:"updated_at,omitempty"`
@@ -72,7 +70,7 @@ type AppliedDiscount struct {
 	Description string `json:"description,omitempty"`
 	Value       string `json:"value,omitempty"`
 	ValueType   string `json:"value_type,omitempty"`
-	Amount      string `json:"amount,omitempty"`
+	Amount      *Money `json:"amount,omitempty"`
 }
 
 // DraftOrderInvoice is the struct used to create an invoice for a draft order
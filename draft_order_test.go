@@ -24,12 +24,12 @@ func draftOrderTests(t *testing.T, draftOrder DraftOrder) {
 
 	// Check prices
 	p := "206.25"
-	if !(p == draftOrder.TotalPrice) {
+	if draftOrder.TotalPrice == nil || draftOrder.TotalPrice.StringFixed(2) != p {
 		t.Errorf("draftOrder.TotalPrice returned %+v, expected %+v", draftOrder.TotalPrice, p)
 	}
 
 	// Check null prices, notice that prices are usually not empty.
-	if draftOrder.TotalTax != "0.00" {
+	if draftOrder.TotalTax == nil || !draftOrder.TotalTax.IsZero() {
 		t.Errorf("draftOrder.TotalTax returned %+v, expected %+v", draftOrder.TotalTax, nil)
 	}
 
@@ -45,7 +45,7 @@ func draftOrderTests(t *testing.T, draftOrder DraftOrder) {
 
 	ptp := decimal.NewFromFloat(199)
 	lineItem := draftOrder.LineItems[0]
-	if !ptp.Equals(*lineItem.Price) {
+	if !ptp.Equals(lineItem.Price.Decimal) {
 		t.Errorf("DraftOrder.LineItems[0].Price, expected %v, actual %v", "199.00", lineItem.Price)
 	}
 }
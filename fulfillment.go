@@ -17,6 +17,7 @@ type FulfillmentService interface {
 	Complete(int64) (*Fulfillment, error)
 	Transition(int64) (*Fulfillment, error)
 	Cancel(int64) (*Fulfillment, error)
+	UpdateTracking(int64, FulfillmentTrackingInfo, bool) (*Fulfillment, error)
 }
 
 // FulfillmentsService is an interface for other Shopify resources
@@ -59,6 +60,24 @@ type Fulfillment struct {
 	Receipt         Receipt    `json:"receipt,omitempty"`
 	LineItems       []LineItem `json:"line_items,omitempty"`
 	NotifyCustomer  bool       `json:"notify_customer"`
+
+	// Used to create fulfillments for fulfillment orders, see FulfillmentOrderService
+	LineItemsByFulfillmentOrder []FulfillmentOrderLineItems `json:"line_items_by_fulfillment_order,omitempty"`
+	TrackingInfo                *FulfillmentTrackingInfo    `json:"tracking_info,omitempty"`
+}
+
+// FulfillmentOrderLineItems selects the line items of a fulfillment order to
+// fulfill. Without line items, all the remaining line items are fulfilled.
+type FulfillmentOrderLineItems struct {
+	FulfillmentOrderID        int64                              `json:"fulfillment_order_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentTrackingInfo represents the tracking information of a fulfillment
+type FulfillmentTrackingInfo struct {
+	Number  string `json:"number,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Company string `json:"company,omitempty"`
 }
 
 // Receipt represents a Shopify receipt.
@@ -148,3 +167,22 @@ func (s *FulfillmentServiceOp) Cancel(fulfillmentID int64) (*Fulfillment, error)
 	err := s.client.Post(path, nil, resource)
 	return resource.Fulfillment, err
 }
+
+// UpdateTracking changes the tracking company, number and url of an existing
+// fulfillment, notifying the customer if notifyCustomer is true. The
+// fulfillment is identified by its ID alone, regardless of the resource of the
+// service.
+func (s *FulfillmentServiceOp) UpdateTracking(fulfillmentID int64, trackingInfo FulfillmentTrackingInfo, notifyCustomer bool) (*Fulfillment, error) {
+	path := fmt.Sprintf("%s/%d/update_tracking.json", FulfillmentPathPrefix("", 0), fulfillmentID)
+	wrappedData := struct {
+		Fulfillment struct {
+			NotifyCustomer bool                    `json:"notify_customer"`
+			TrackingInfo   FulfillmentTrackingInfo `json:"tracking_info"`
+		} `json:"fulfillment"`
+	}{}
+	wrappedData.Fulfillment.NotifyCustomer = notifyCustomer
+	wrappedData.Fulfillment.TrackingInfo = trackingInfo
+	resource := new(FulfillmentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Fulfillment, err
+}
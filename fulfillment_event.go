@@ -0,0 +1,108 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+// FulfillmentEventStatus is the status of a shipment reported by a
+// fulfillment event
+type FulfillmentEventStatus string
+
+// Statuses of a fulfillment event
+const (
+	FulfillmentEventLabelPrinted      FulfillmentEventStatus = "label_printed"
+	FulfillmentEventLabelPurchased    FulfillmentEventStatus = "label_purchased"
+	FulfillmentEventConfirmed         FulfillmentEventStatus = "confirmed"
+	FulfillmentEventReadyForPickup    FulfillmentEventStatus = "ready_for_pickup"
+	FulfillmentEventInTransit         FulfillmentEventStatus = "in_transit"
+	FulfillmentEventOutForDelivery    FulfillmentEventStatus = "out_for_delivery"
+	FulfillmentEventAttemptedDelivery FulfillmentEventStatus = "attempted_delivery"
+	FulfillmentEventDelivered         FulfillmentEventStatus = "delivered"
+	FulfillmentEventFailure           FulfillmentEventStatus = "failure"
+)
+
+// FulfillmentEventService is an interface for interfacing with the
+// fulfillment event endpoints of the Shopify API, reporting the progress of a
+// shipment.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shipping-and-fulfillment/fulfillmentevent
+type FulfillmentEventService interface {
+	List(int64, int64, interface{}) ([]FulfillmentEvent, error)
+	Get(int64, int64, int64, interface{}) (*FulfillmentEvent, error)
+	Create(int64, int64, FulfillmentEvent) (*FulfillmentEvent, error)
+	Delete(int64, int64, int64) error
+}
+
+// FulfillmentEventServiceOp handles communication with the fulfillment event
+// related methods of the Shopify API.
+type FulfillmentEventServiceOp struct {
+	client *Client
+}
+
+// FulfillmentEvent represents a Shopify fulfillment event
+type FulfillmentEvent struct {
+	ID                  int64                  `json:"id,omitempty"`
+	FulfillmentID       int64                  `json:"fulfillment_id,omitempty"`
+	OrderID             int64                  `json:"order_id,omitempty"`
+	ShopID              int64                  `json:"shop_id,omitempty"`
+	Status              FulfillmentEventStatus `json:"status,omitempty"`
+	Message             string                 `json:"message,omitempty"`
+	HappenedAt          *time.Time             `json:"happened_at,omitempty"`
+	EstimatedDeliveryAt *time.Time             `json:"estimated_delivery_at,omitempty"`
+	City                string                 `json:"city,omitempty"`
+	Province            string                 `json:"province,omitempty"`
+	Country             string                 `json:"country,omitempty"`
+	Zip                 string                 `json:"zip,omitempty"`
+	Address1            string                 `json:"address1,omitempty"`
+	Latitude            float64                `json:"latitude,omitempty"`
+	Longitude           float64                `json:"longitude,omitempty"`
+	CreatedAt           *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time             `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID   string                 `json:"admin_graphql_api_id,omitempty"`
+}
+
+// FulfillmentEventResource represents the result from the events/X.json endpoint
+type FulfillmentEventResource struct {
+	FulfillmentEvent *FulfillmentEvent `json:"fulfillment_event"`
+}
+
+// FulfillmentEventsResource represents the result from the events.json endpoint
+type FulfillmentEventsResource struct {
+	FulfillmentEvents []FulfillmentEvent `json:"fulfillment_events"`
+}
+
+func fulfillmentEventsPath(orderID int64, fulfillmentID int64) string {
+	return fmt.Sprintf("%s/%d/fulfillments/%d/events", ordersBasePath, orderID, fulfillmentID)
+}
+
+// List fulfillment events of a fulfillment
+func (s *FulfillmentEventServiceOp) List(orderID int64, fulfillmentID int64, options interface{}) ([]FulfillmentEvent, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentEventsPath(orderID, fulfillmentID))
+	resource := new(FulfillmentEventsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentEvents, err
+}
+
+// Get individual fulfillment event
+func (s *FulfillmentEventServiceOp) Get(orderID int64, fulfillmentID int64, eventID int64, options interface{}) (*FulfillmentEvent, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentEventsPath(orderID, fulfillmentID), eventID)
+	resource := new(FulfillmentEventResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentEvent, err
+}
+
+// Create a new fulfillment event
+func (s *FulfillmentEventServiceOp) Create(orderID int64, fulfillmentID int64, event FulfillmentEvent) (*FulfillmentEvent, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentEventsPath(orderID, fulfillmentID))
+	wrappedData := struct {
+		Event *FulfillmentEvent `json:"event"`
+	}{&event}
+	resource := new(FulfillmentEventResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentEvent, err
+}
+
+// Delete an existing fulfillment event
+func (s *FulfillmentEventServiceOp) Delete(orderID int64, fulfillmentID int64, eventID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", fulfillmentEventsPath(orderID, fulfillmentID), eventID))
+}
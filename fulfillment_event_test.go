@@ -0,0 +1,117 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentEventTests(t *testing.T, event *FulfillmentEvent) {
+	if event == nil {
+		t.Fatal("FulfillmentEvent is nil")
+	}
+
+	happenedAt, _ := time.Parse(time.RFC3339, "2021-01-15T10:20:19-05:00")
+	expected := &FulfillmentEvent{
+		ID:                944956395,
+		FulfillmentID:     255858046,
+		OrderID:           450789469,
+		ShopID:            548380009,
+		Status:            FulfillmentEventInTransit,
+		Message:           "Package picked up by carrier",
+		HappenedAt:        &happenedAt,
+		City:              "Louisville",
+		Province:          "Kentucky",
+		Country:           "United States",
+		Zip:               "40202",
+		Address1:          "Chestnut Street 92",
+		Latitude:          38.2539,
+		Longitude:         -85.7562,
+		CreatedAt:         &happenedAt,
+		UpdatedAt:         &happenedAt,
+		AdminGraphqlAPIID: "gid://shopify/FulfillmentEvent/944956395",
+	}
+
+	if !reflect.DeepEqual(event, expected) {
+		t.Errorf("FulfillmentEvent returned %+v, expected %+v", event, expected)
+	}
+}
+
+func TestFulfillmentEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/fulfillments/255858046/events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_events.json")))
+
+	events, err := client.FulfillmentEvent.List(450789469, 255858046, nil)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.List returned error: %v", err)
+	}
+
+	if len(events) != 2 || events[1].Status != FulfillmentEventDelivered {
+		t.Fatalf("FulfillmentEvent.List returned %+v", events)
+	}
+	fulfillmentEventTests(t, &events[0])
+}
+
+func TestFulfillmentEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/fulfillments/255858046/events/944956395.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_event.json")))
+
+	event, err := client.FulfillmentEvent.Get(450789469, 255858046, 944956395, nil)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Get returned error: %v", err)
+	}
+
+	fulfillmentEventTests(t, event)
+}
+
+func TestFulfillmentEventCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/fulfillments/255858046/events.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("fulfillment_event.json")), nil
+		})
+
+	event, err := client.FulfillmentEvent.Create(450789469, 255858046, FulfillmentEvent{
+		Status:  FulfillmentEventInTransit,
+		Message: "Package picked up by carrier",
+	})
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"event":{"status":"in_transit","message":"Package picked up by carrier"}}`
+	if body != expectedBody {
+		t.Errorf("FulfillmentEvent.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	fulfillmentEventTests(t, event)
+}
+
+func TestFulfillmentEventDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/fulfillments/255858046/events/944956395.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentEvent.Delete(450789469, 255858046, 944956395)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const fulfillmentOrdersBasePath = "fulfillment_orders"
+
+// Statuses of a fulfillment order
+const (
+	FulfillmentOrderStatusOpen       = "open"
+	FulfillmentOrderStatusInProgress = "in_progress"
+	FulfillmentOrderStatusScheduled  = "scheduled"
+	FulfillmentOrderStatusOnHold     = "on_hold"
+	FulfillmentOrderStatusIncomplete = "incomplete"
+	FulfillmentOrderStatusCancelled  = "cancelled"
+	FulfillmentOrderStatusClosed     = "closed"
+)
+
+// Request statuses of a fulfillment order, tracking the requests made to a
+// fulfillment service
+const (
+	FulfillmentOrderRequestStatusUnsubmitted          = "unsubmitted"
+	FulfillmentOrderRequestStatusSubmitted            = "submitted"
+	FulfillmentOrderRequestStatusAccepted             = "accepted"
+	FulfillmentOrderRequestStatusRejected             = "rejected"
+	FulfillmentOrderRequestStatusCancellationRequest  = "cancellation_requested"
+	FulfillmentOrderRequestStatusCancellationAccepted = "cancellation_accepted"
+	FulfillmentOrderRequestStatusCancellationRejected = "cancellation_rejected"
+	FulfillmentOrderRequestStatusClosed               = "closed"
+)
+
+// Reasons for holding a fulfillment order
+const (
+	FulfillmentHoldReasonAwaitingPayment     = "awaiting_payment"
+	FulfillmentHoldReasonHighRiskOfFraud     = "high_risk_of_fraud"
+	FulfillmentHoldReasonIncorrectAddress    = "incorrect_address"
+	FulfillmentHoldReasonInventoryOutOfStock = "inventory_out_of_stock"
+	FulfillmentHoldReasonOther               = "other"
+)
+
+// FulfillmentOrderService is an interface for interfacing with the fulfillment
+// order endpoints of the Shopify API. Fulfillment orders replace the
+// deprecated fulfillment flow of FulfillmentService, fulfillments for them are
+// created with Fulfillment.LineItemsByFulfillmentOrder.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shipping-and-fulfillment/fulfillmentorder
+type FulfillmentOrderService interface {
+	List(int64, interface{}) ([]FulfillmentOrder, error)
+	Get(int64, interface{}) (*FulfillmentOrder, error)
+	Move(int64, FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResult, error)
+	Hold(int64, FulfillmentOrderHoldRequest) (*FulfillmentOrder, error)
+	ReleaseHold(int64) (*FulfillmentOrder, error)
+	Cancel(int64) (*FulfillmentOrderCancelResult, error)
+	Close(int64, string) (*FulfillmentOrder, error)
+	Reschedule(int64, time.Time) (*FulfillmentOrder, error)
+	RequestFulfillment(int64, FulfillmentRequest) (*FulfillmentRequestResult, error)
+	RequestCancellation(int64, string) (*FulfillmentOrder, error)
+}
+
+// FulfillmentOrderServiceOp handles communication with the fulfillment order
+// related methods of the Shopify API.
+type FulfillmentOrderServiceOp struct {
+	client *Client
+}
+
+// FulfillmentOrder represents a group of line items of an order that are
+// fulfilled from the same location.
+type FulfillmentOrder struct {
+	ID                 int64                             `json:"id,omitempty"`
+	ShopID             int64                             `json:"shop_id,omitempty"`
+	OrderID            int64                             `json:"order_id,omitempty"`
+	AssignedLocationID int64                             `json:"assigned_location_id,omitempty"`
+	RequestStatus      string                            `json:"request_status,omitempty"`
+	Status             string                            `json:"status,omitempty"`
+	SupportedActions   []string                          `json:"supported_actions,omitempty"`
+	Destination        *FulfillmentOrderDestination      `json:"destination,omitempty"`
+	LineItems          []FulfillmentOrderLineItem        `json:"line_items,omitempty"`
+	FulfillAt          *time.Time                        `json:"fulfill_at,omitempty"`
+	FulfillBy          *time.Time                        `json:"fulfill_by,omitempty"`
+	FulfillmentHolds   []FulfillmentOrderHold            `json:"fulfillment_holds,omitempty"`
+	DeliveryMethod     *FulfillmentOrderDeliveryMethod   `json:"delivery_method,omitempty"`
+	AssignedLocation   *FulfillmentOrderAssignedLocation `json:"assigned_location,omitempty"`
+	MerchantRequests   []FulfillmentOrderMerchantRequest `json:"merchant_requests,omitempty"`
+	CreatedAt          *time.Time                        `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time                        `json:"updated_at,omitempty"`
+}
+
+// FulfillmentOrderDestination is the address a fulfillment order is shipped to
+type FulfillmentOrderDestination struct {
+	ID        int64  `json:"id,omitempty"`
+	Address1  string `json:"address1,omitempty"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Province  string `json:"province,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+}
+
+// FulfillmentOrderAssignedLocation is the location a fulfillment order is
+// assigned to, as it was when the fulfillment order was created
+type FulfillmentOrderAssignedLocation struct {
+	LocationID  int64  `json:"location_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Address1    string `json:"address1,omitempty"`
+	Address2    string `json:"address2,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+	Phone       string `json:"phone,omitempty"`
+	Province    string `json:"province,omitempty"`
+	Zip         string `json:"zip,omitempty"`
+}
+
+// FulfillmentOrderLineItem represents a line item of a fulfillment order
+type FulfillmentOrderLineItem struct {
+	ID                  int64 `json:"id,omitempty"`
+	ShopID              int64 `json:"shop_id,omitempty"`
+	FulfillmentOrderID  int64 `json:"fulfillment_order_id,omitempty"`
+	LineItemID          int64 `json:"line_item_id,omitempty"`
+	InventoryItemID     int64 `json:"inventory_item_id,omitempty"`
+	VariantID           int64 `json:"variant_id,omitempty"`
+	Quantity            int   `json:"quantity,omitempty"`
+	FulfillableQuantity int   `json:"fulfillable_quantity,omitempty"`
+}
+
+// FulfillmentOrderLineItemQuantity selects a quantity of a fulfillment order
+// line item
+type FulfillmentOrderLineItemQuantity struct {
+	ID       int64 `json:"id"`
+	Quantity int   `json:"quantity"`
+}
+
+// FulfillmentOrderHold represents the reason a fulfillment order is on hold
+type FulfillmentOrderHold struct {
+	Reason      string `json:"reason,omitempty"`
+	ReasonNotes string `json:"reason_notes,omitempty"`
+}
+
+// FulfillmentOrderDeliveryMethod represents how a fulfillment order is delivered
+type FulfillmentOrderDeliveryMethod struct {
+	ID         int64  `json:"id,omitempty"`
+	MethodType string `json:"method_type,omitempty"`
+}
+
+// FulfillmentOrderMerchantRequest represents a request made by the merchant
+// to the fulfillment service of a fulfillment order
+type FulfillmentOrderMerchantRequest struct {
+	Message        string                 `json:"message,omitempty"`
+	RequestOptions map[string]interface{} `json:"request_options,omitempty"`
+	Kind           string                 `json:"kind,omitempty"`
+}
+
+// FulfillmentOrderMoveRequest moves a fulfillment order, or some of its line
+// items, to a new location
+type FulfillmentOrderMoveRequest struct {
+	NewLocationID             int64                              `json:"new_location_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentOrderHoldRequest puts a fulfillment order on hold
+type FulfillmentOrderHoldRequest struct {
+	Reason                    string                             `json:"reason"`
+	ReasonNotes               string                             `json:"reason_notes,omitempty"`
+	NotifyMerchant            bool                               `json:"notify_merchant,omitempty"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentRequest requests the fulfillment service of a fulfillment order
+// to fulfill it, or some of its line items
+type FulfillmentRequest struct {
+	Message                   string                             `json:"message,omitempty"`
+	NotifyCustomer            bool                               `json:"notify_customer,omitempty"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+type fulfillmentOrderMessage struct {
+	Message string `json:"message,omitempty"`
+}
+
+// FulfillmentOrderMoveResult is the result of moving a fulfillment order
+type FulfillmentOrderMoveResult struct {
+	OriginalFulfillmentOrder  *FulfillmentOrder `json:"original_fulfillment_order"`
+	MovedFulfillmentOrder     *FulfillmentOrder `json:"moved_fulfillment_order"`
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remaining_fulfillment_order"`
+}
+
+// FulfillmentOrderCancelResult is the result of cancelling a fulfillment order
+type FulfillmentOrderCancelResult struct {
+	FulfillmentOrder            *FulfillmentOrder `json:"fulfillment_order"`
+	ReplacementFulfillmentOrder *FulfillmentOrder `json:"replacement_fulfillment_order"`
+}
+
+// FulfillmentRequestResult is the result of a fulfillment request
+type FulfillmentRequestResult struct {
+	OriginalFulfillmentOrder    *FulfillmentOrder `json:"original_fulfillment_order"`
+	SubmittedFulfillmentOrder   *FulfillmentOrder `json:"submitted_fulfillment_order"`
+	UnsubmittedFulfillmentOrder *FulfillmentOrder `json:"unsubmitted_fulfillment_order"`
+}
+
+// FulfillmentOrderResource represents the result from the fulfillment_orders/X.json endpoint
+type FulfillmentOrderResource struct {
+	FulfillmentOrder *FulfillmentOrder `json:"fulfillment_order"`
+}
+
+// FulfillmentOrdersResource represents the result from the orders/X/fulfillment_orders.json endpoint
+type FulfillmentOrdersResource struct {
+	FulfillmentOrders []FulfillmentOrder `json:"fulfillment_orders"`
+}
+
+// List fulfillment orders of an order
+func (s *FulfillmentOrderServiceOp) List(orderID int64, options interface{}) ([]FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, fulfillmentOrdersBasePath)
+	resource := new(FulfillmentOrdersResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentOrders, err
+}
+
+// Get individual fulfillment order
+func (s *FulfillmentOrderServiceOp) Get(fulfillmentOrderID int64, options interface{}) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentOrder, err
+}
+
+// Move a fulfillment order to a new location
+func (s *FulfillmentOrderServiceOp) Move(fulfillmentOrderID int64, request FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResult, error) {
+	path := fmt.Sprintf("%s/%d/move.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		FulfillmentOrder FulfillmentOrderMoveRequest `json:"fulfillment_order"`
+	}{request}
+	resource := new(FulfillmentOrderMoveResult)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource, err
+}
+
+// Hold a fulfillment order
+func (s *FulfillmentOrderServiceOp) Hold(fulfillmentOrderID int64, request FulfillmentOrderHoldRequest) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/hold.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		FulfillmentHold FulfillmentOrderHoldRequest `json:"fulfillment_hold"`
+	}{request}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// ReleaseHold releases the hold on a fulfillment order
+func (s *FulfillmentOrderServiceOp) ReleaseHold(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/release_hold.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Cancel a fulfillment order
+func (s *FulfillmentOrderServiceOp) Cancel(fulfillmentOrderID int64) (*FulfillmentOrderCancelResult, error) {
+	path := fmt.Sprintf("%s/%d/cancel.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderCancelResult)
+	err := s.client.Post(path, nil, resource)
+	return resource, err
+}
+
+// Close a fulfillment order that was in progress, marking it as incomplete
+func (s *FulfillmentOrderServiceOp) Close(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/close.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		FulfillmentOrder fulfillmentOrderMessage `json:"fulfillment_order"`
+	}{fulfillmentOrderMessage{message}}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Reschedule the fulfill at time of a scheduled fulfillment order
+func (s *FulfillmentOrderServiceOp) Reschedule(fulfillmentOrderID int64, newFulfillAt time.Time) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/reschedule.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		FulfillmentOrder struct {
+			NewFulfillAt time.Time `json:"new_fulfill_at"`
+		} `json:"fulfillment_order"`
+	}{}
+	wrappedData.FulfillmentOrder.NewFulfillAt = newFulfillAt
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// RequestFulfillment sends a fulfillment request to the fulfillment service of
+// a fulfillment order
+func (s *FulfillmentOrderServiceOp) RequestFulfillment(fulfillmentOrderID int64, request FulfillmentRequest) (*FulfillmentRequestResult, error) {
+	path := fmt.Sprintf("%s/%d/fulfillment_request.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		FulfillmentRequest FulfillmentRequest `json:"fulfillment_request"`
+	}{request}
+	resource := new(FulfillmentRequestResult)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource, err
+}
+
+// RequestCancellation sends a cancellation request to the fulfillment service
+// of a fulfillment order
+func (s *FulfillmentOrderServiceOp) RequestCancellation(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/cancellation_request.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := struct {
+		CancellationRequest fulfillmentOrderMessage `json:"cancellation_request"`
+	}{fulfillmentOrderMessage{message}}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
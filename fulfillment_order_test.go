@@ -0,0 +1,180 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentOrderTests(t *testing.T, fulfillmentOrder *FulfillmentOrder) {
+	if fulfillmentOrder == nil {
+		t.Fatal("FulfillmentOrder is nil")
+	}
+
+	expectedInt := int64(1046000778)
+	if fulfillmentOrder.ID != expectedInt {
+		t.Errorf("FulfillmentOrder.ID returned %+v, expected %+v", fulfillmentOrder.ID, expectedInt)
+	}
+
+	if fulfillmentOrder.Status != FulfillmentOrderStatusOpen || fulfillmentOrder.RequestStatus != FulfillmentOrderRequestStatusUnsubmitted {
+		t.Errorf("FulfillmentOrder statuses are %s and %s, expected open and unsubmitted", fulfillmentOrder.Status, fulfillmentOrder.RequestStatus)
+	}
+
+	if len(fulfillmentOrder.LineItems) != 1 || fulfillmentOrder.LineItems[0].LineItemID != 466157049 || fulfillmentOrder.LineItems[0].FulfillableQuantity != 1 {
+		t.Errorf("FulfillmentOrder.LineItems returned %+v", fulfillmentOrder.LineItems)
+	}
+
+	if fulfillmentOrder.Destination == nil || fulfillmentOrder.Destination.Zip != "40202" {
+		t.Errorf("FulfillmentOrder.Destination returned %+v", fulfillmentOrder.Destination)
+	}
+
+	if fulfillmentOrder.AssignedLocation == nil || fulfillmentOrder.AssignedLocation.LocationID != 24826418 {
+		t.Errorf("FulfillmentOrder.AssignedLocation returned %+v", fulfillmentOrder.AssignedLocation)
+	}
+}
+
+func TestFulfillmentOrderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/fulfillment_orders.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_orders.json")))
+
+	fulfillmentOrders, err := client.FulfillmentOrder.List(450789469, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.List returned error: %v", err)
+	}
+
+	if len(fulfillmentOrders) != 1 {
+		t.Fatalf("FulfillmentOrder.List returned %d fulfillment orders, expected 1", len(fulfillmentOrders))
+	}
+	fulfillmentOrderTests(t, &fulfillmentOrders[0])
+}
+
+func TestFulfillmentOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000778.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.FulfillmentOrder.Get(1046000778, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Get returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, fulfillmentOrder)
+}
+
+func TestFulfillmentOrderActions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fulfillAt := time.Date(2021, time.January, 20, 10, 0, 0, 0, time.UTC)
+	lineItems := []FulfillmentOrderLineItemQuantity{{ID: 1058737482, Quantity: 1}}
+
+	cases := []struct {
+		action       string
+		response     string
+		call         func() (*FulfillmentOrder, error)
+		expectedBody string
+	}{
+		{
+			"move",
+			`{"original_fulfillment_order":{"id":1046000778,"status":"closed"},"moved_fulfillment_order":{"id":1046000779,"status":"open"},"remaining_fulfillment_order":null}`,
+			func() (*FulfillmentOrder, error) {
+				result, err := client.FulfillmentOrder.Move(1046000778, FulfillmentOrderMoveRequest{NewLocationID: 905684977, FulfillmentOrderLineItems: lineItems})
+				return result.MovedFulfillmentOrder, err
+			},
+			`{"fulfillment_order":{"new_location_id":905684977,"fulfillment_order_line_items":[{"id":1058737482,"quantity":1}]}}`,
+		},
+		{
+			"hold",
+			`{"fulfillment_order":{"id":1046000778,"status":"on_hold"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.Hold(1046000778, FulfillmentOrderHoldRequest{Reason: FulfillmentHoldReasonInventoryOutOfStock, ReasonNotes: "Not enough inventory"})
+			},
+			`{"fulfillment_hold":{"reason":"inventory_out_of_stock","reason_notes":"Not enough inventory"}}`,
+		},
+		{
+			"release_hold",
+			`{"fulfillment_order":{"id":1046000778,"status":"open"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.ReleaseHold(1046000778)
+			},
+			``,
+		},
+		{
+			"cancel",
+			`{"fulfillment_order":{"id":1046000778,"status":"closed"},"replacement_fulfillment_order":{"id":1046000780,"status":"open"}}`,
+			func() (*FulfillmentOrder, error) {
+				result, err := client.FulfillmentOrder.Cancel(1046000778)
+				return result.ReplacementFulfillmentOrder, err
+			},
+			``,
+		},
+		{
+			"close",
+			`{"fulfillment_order":{"id":1046000778,"status":"incomplete"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.Close(1046000778, "Not enough inventory to complete this work.")
+			},
+			`{"fulfillment_order":{"message":"Not enough inventory to complete this work."}}`,
+		},
+		{
+			"reschedule",
+			`{"fulfillment_order":{"id":1046000778,"status":"scheduled","fulfill_at":"2021-01-20T10:00:00Z"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.Reschedule(1046000778, fulfillAt)
+			},
+			`{"fulfillment_order":{"new_fulfill_at":"2021-01-20T10:00:00Z"}}`,
+		},
+		{
+			"fulfillment_request",
+			`{"original_fulfillment_order":{"id":1046000778,"status":"closed"},"submitted_fulfillment_order":{"id":1046000778,"status":"open","request_status":"submitted"},"unsubmitted_fulfillment_order":null}`,
+			func() (*FulfillmentOrder, error) {
+				result, err := client.FulfillmentOrder.RequestFulfillment(1046000778, FulfillmentRequest{Message: "Fulfill this ASAP please.", FulfillmentOrderLineItems: lineItems})
+				return result.SubmittedFulfillmentOrder, err
+			},
+			`{"fulfillment_request":{"message":"Fulfill this ASAP please.","fulfillment_order_line_items":[{"id":1058737482,"quantity":1}]}}`,
+		},
+		{
+			"cancellation_request",
+			`{"fulfillment_order":{"id":1046000778,"status":"in_progress","request_status":"cancellation_requested"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.RequestCancellation(1046000778, "The customer changed his mind.")
+			},
+			`{"cancellation_request":{"message":"The customer changed his mind."}}`,
+		},
+	}
+
+	for _, c := range cases {
+		var body string
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000778/%s.json", client.pathPrefix, c.action),
+			func(req *http.Request) (*http.Response, error) {
+				if req.Body != nil {
+					b, _ := ioutil.ReadAll(req.Body)
+					body = string(b)
+				}
+				return httpmock.NewStringResponse(200, c.response), nil
+			})
+
+		fulfillmentOrder, err := c.call()
+		if err != nil {
+			t.Errorf("FulfillmentOrder %s returned error: %v", c.action, err)
+			continue
+		}
+
+		if body != c.expectedBody {
+			t.Errorf("FulfillmentOrder %s sent %s, expected %s", c.action, body, c.expectedBody)
+		}
+
+		if fulfillmentOrder == nil || fulfillmentOrder.ID == 0 || fulfillmentOrder.Status == "" {
+			t.Errorf("FulfillmentOrder %s returned %+v", c.action, fulfillmentOrder)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package goshopify
+
+import "fmt"
+
+const fulfillmentServicesBasePath = "fulfillment_services"
+
+// Scopes for listing fulfillment services
+const (
+	FulfillmentServiceScopeCurrentClient = "current_client"
+	FulfillmentServiceScopeAll           = "all"
+)
+
+// FulfillmentServiceService is an interface for interfacing with the
+// fulfillment service endpoints of the Shopify API, used by third party
+// warehouses to register themselves with a shop. See FulfillmentServiceHandler
+// for serving the callbacks of a fulfillment service.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shipping-and-fulfillment/fulfillmentservice
+type FulfillmentServiceService interface {
+	List(interface{}) ([]FulfillmentServiceData, error)
+	Get(int64, interface{}) (*FulfillmentServiceData, error)
+	Create(FulfillmentServiceData) (*FulfillmentServiceData, error)
+	Update(FulfillmentServiceData) (*FulfillmentServiceData, error)
+	Delete(int64) error
+}
+
+// FulfillmentServiceServiceOp handles communication with the fulfillment
+// service related methods of the Shopify API.
+type FulfillmentServiceServiceOp struct {
+	client *Client
+}
+
+// FulfillmentServiceData represents a Shopify fulfillment service. Shopify
+// calls CallbackURL for fulfillment order notifications and, depending on
+// TrackingSupport and InventoryManagement, to fetch tracking numbers and stock.
+// The settings are pointers so that Update leaves those that are nil unchanged.
+type FulfillmentServiceData struct {
+	ID                     int64  `json:"id,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	Email                  string `json:"email,omitempty"`
+	ServiceName            string `json:"service_name,omitempty"`
+	Handle                 string `json:"handle,omitempty"`
+	ProviderID             string `json:"provider_id,omitempty"`
+	LocationID             int64  `json:"location_id,omitempty"`
+	CallbackURL            string `json:"callback_url,omitempty"`
+	Format                 string `json:"format,omitempty"`
+	InventoryManagement    *bool  `json:"inventory_management,omitempty"`
+	TrackingSupport        *bool  `json:"tracking_support,omitempty"`
+	RequiresShippingMethod *bool  `json:"requires_shipping_method,omitempty"`
+	FulfillmentOrdersOptIn *bool  `json:"fulfillment_orders_opt_in,omitempty"`
+	IncludePendingStock    *bool  `json:"include_pending_stock,omitempty"`
+	PermitsSkuSharing      *bool  `json:"permits_sku_sharing,omitempty"`
+	AdminGraphqlAPIID      string `json:"admin_graphql_api_id,omitempty"`
+}
+
+// FulfillmentServiceOptions can be used for filtering fulfillment services on
+// a List request.
+type FulfillmentServiceOptions struct {
+	Scope string `url:"scope,omitempty"`
+}
+
+// FulfillmentServiceResource represents the result from the fulfillment_services/X.json endpoint
+type FulfillmentServiceResource struct {
+	FulfillmentService *FulfillmentServiceData `json:"fulfillment_service"`
+}
+
+// FulfillmentServicesResource represents the result from the fulfillment_services.json endpoint
+type FulfillmentServicesResource struct {
+	FulfillmentServices []FulfillmentServiceData `json:"fulfillment_services"`
+}
+
+// List fulfillment services
+func (s *FulfillmentServiceServiceOp) List(options interface{}) ([]FulfillmentServiceData, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
+	resource := new(FulfillmentServicesResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentServices, err
+}
+
+// Get individual fulfillment service
+func (s *FulfillmentServiceServiceOp) Get(fulfillmentServiceID int64, options interface{}) (*FulfillmentServiceData, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID)
+	resource := new(FulfillmentServiceResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentService, err
+}
+
+// Create a new fulfillment service
+func (s *FulfillmentServiceServiceOp) Create(fulfillmentService FulfillmentServiceData) (*FulfillmentServiceData, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
+	wrappedData := FulfillmentServiceResource{FulfillmentService: &fulfillmentService}
+	resource := new(FulfillmentServiceResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentService, err
+}
+
+// Update an existing fulfillment service
+func (s *FulfillmentServiceServiceOp) Update(fulfillmentService FulfillmentServiceData) (*FulfillmentServiceData, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentService.ID)
+	wrappedData := FulfillmentServiceResource{FulfillmentService: &fulfillmentService}
+	resource := new(FulfillmentServiceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.FulfillmentService, err
+}
+
+// Delete an existing fulfillment service
+func (s *FulfillmentServiceServiceOp) Delete(fulfillmentServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID))
+}
@@ -0,0 +1,172 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Kinds of fulfillment order notifications
+const (
+	FulfillmentOrderNotificationFulfillmentRequest  = "FULFILLMENT_REQUEST"
+	FulfillmentOrderNotificationCancellationRequest = "CANCELLATION_REQUEST"
+)
+
+// FulfillmentOrderNotification is sent by Shopify to the callback URL of a
+// fulfillment service when the merchant requests the fulfillment, or the
+// cancellation, of fulfillment orders assigned to it.
+type FulfillmentOrderNotification struct {
+	Kind string `json:"kind"`
+}
+
+// FulfillmentServiceTrackingNumbers is the response to a tracking numbers
+// request, mapping order names (e.g. "#1001.1") to tracking numbers.
+type FulfillmentServiceTrackingNumbers struct {
+	TrackingNumbers map[string]string `json:"tracking_numbers"`
+	Message         string            `json:"message,omitempty"`
+	Success         bool              `json:"success"`
+}
+
+// FulfillmentServiceCallbacks is implemented by fulfillment service apps to
+// answer the callbacks served by FulfillmentServiceHandler. The shop is the
+// myshopify.com domain of the shop making the request.
+type FulfillmentServiceCallbacks interface {
+	// FulfillmentOrderNotification is called when fulfillment orders were
+	// assigned to the fulfillment service, or their cancellation was
+	// requested. The fulfillment orders themselves are fetched from the API.
+	FulfillmentOrderNotification(shop string, notification FulfillmentOrderNotification) error
+	// FetchTrackingNumbers returns the tracking numbers of the fulfillments
+	// with the given names. Called when TrackingSupport is enabled.
+	FetchTrackingNumbers(shop string, orderNames []string) (*FulfillmentServiceTrackingNumbers, error)
+	// FetchStock returns the stock levels by SKU, for the given SKU only when
+	// sku isn't empty. Called when InventoryManagement is enabled.
+	FetchStock(shop string, sku string) (map[string]int, error)
+}
+
+// FulfillmentServiceHandler is an http.Handler serving the callbacks Shopify
+// makes to the CallbackURL of a fulfillment service:
+// /fulfillment_order_notification, /fetch_tracking_numbers and /fetch_stock,
+// with or without a .json extension. Notifications are verified with the app
+// secret like webhooks, fetch requests by the hmac parameter of their query
+// like OAuth callbacks. Unverified requests are answered with 401 Unauthorized.
+type FulfillmentServiceHandler struct {
+	app       App
+	callbacks FulfillmentServiceCallbacks
+}
+
+// NewFulfillmentServiceHandler returns a FulfillmentServiceHandler delegating
+// to the callbacks.
+func NewFulfillmentServiceHandler(app App, callbacks FulfillmentServiceCallbacks) *FulfillmentServiceHandler {
+	return &FulfillmentServiceHandler{app: app, callbacks: callbacks}
+}
+
+// ServeHTTP dispatches a callback request to the FulfillmentServiceCallbacks.
+func (h *FulfillmentServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimSuffix(path.Base(r.URL.Path), ".json") {
+	case "fulfillment_order_notification":
+		h.serveNotification(w, r)
+	case "fetch_tracking_numbers":
+		h.serveTrackingNumbers(w, r)
+	case "fetch_stock":
+		h.serveStock(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *FulfillmentServiceHandler) serveNotification(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+
+	if ok, _ := h.app.VerifyWebhookRequestVerbose(r); !ok {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	notification := FulfillmentOrderNotification{}
+	if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.callbacks.FulfillmentOrderNotification(callbackShop(r), notification); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *FulfillmentServiceHandler) serveTrackingNumbers(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	if !h.verifyFetch(w, r) {
+		return
+	}
+
+	query := r.URL.Query()
+	orderNames := append(query["order_names[]"], query["order_names"]...)
+
+	trackingNumbers, err := h.callbacks.FetchTrackingNumbers(callbackShop(r), orderNames)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, trackingNumbers)
+}
+
+func (h *FulfillmentServiceHandler) serveStock(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	if !h.verifyFetch(w, r) {
+		return
+	}
+
+	stock, err := h.callbacks.FetchStock(callbackShop(r), r.URL.Query().Get("sku"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if stock == nil {
+		stock = map[string]int{}
+	}
+
+	writeJSON(w, stock)
+}
+
+// verifyFetch checks the hmac of a fetch request before its shop parameter is
+// trusted, answering with 401 Unauthorized if it is invalid.
+func (h *FulfillmentServiceHandler) verifyFetch(w http.ResponseWriter, r *http.Request) bool {
+	if ok, err := h.app.VerifyAuthorizationURL(r.URL); !ok || err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+// callbackShop returns the shop domain of a callback request.
+func callbackShop(r *http.Request) string {
+	if shop := r.Header.Get(webhookShopDomainHeader); shop != "" {
+		return shop
+	}
+	return r.URL.Query().Get("shop")
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
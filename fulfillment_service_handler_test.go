@@ -0,0 +1,181 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testFulfillmentServiceCallbacks struct {
+	shop          string
+	notifications []FulfillmentOrderNotification
+	orderNames    []string
+	sku           string
+	err           error
+}
+
+func (c *testFulfillmentServiceCallbacks) FulfillmentOrderNotification(shop string, notification FulfillmentOrderNotification) error {
+	c.shop = shop
+	c.notifications = append(c.notifications, notification)
+	return c.err
+}
+
+func (c *testFulfillmentServiceCallbacks) FetchTrackingNumbers(shop string, orderNames []string) (*FulfillmentServiceTrackingNumbers, error) {
+	c.shop = shop
+	c.orderNames = orderNames
+	trackingNumbers := &FulfillmentServiceTrackingNumbers{TrackingNumbers: map[string]string{}, Success: true}
+	for _, name := range orderNames {
+		trackingNumbers.TrackingNumbers[name] = "TRACK" + strings.TrimPrefix(name, "#")
+	}
+	return trackingNumbers, c.err
+}
+
+func (c *testFulfillmentServiceCallbacks) FetchStock(shop string, sku string) (map[string]int, error) {
+	c.shop = shop
+	c.sku = sku
+	if sku != "" {
+		return map[string]int{sku: 5}, c.err
+	}
+	return map[string]int{"IPOD2008GREEN": 5, "IPOD2008RED": 0}, c.err
+}
+
+func TestFulfillmentServiceHandlerNotification(t *testing.T) {
+	setup()
+	defer teardown()
+
+	callbacks := new(testFulfillmentServiceCallbacks)
+	handler := NewFulfillmentServiceHandler(app, callbacks)
+
+	req := newWebhookRequest("", `{"kind":"FULFILLMENT_REQUEST"}`)
+	req.URL.Path = "/fulfillment/fulfillment_order_notification"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("FulfillmentServiceHandler returned status %d, expected %d", rec.Code, http.StatusCreated)
+	}
+	expected := []FulfillmentOrderNotification{{Kind: FulfillmentOrderNotificationFulfillmentRequest}}
+	if !reflect.DeepEqual(callbacks.notifications, expected) || callbacks.shop != "fooshop.myshopify.com" {
+		t.Errorf("FulfillmentServiceHandler notified %v for %s", callbacks.notifications, callbacks.shop)
+	}
+
+	cases := []struct {
+		description string
+		request     func() *http.Request
+		err         error
+		status      int
+	}{
+		{"unsigned", func() *http.Request {
+			req := newWebhookRequest("", `{"kind":"FULFILLMENT_REQUEST"}`)
+			req.Header.Del("X-Shopify-Hmac-Sha256")
+			return req
+		}, nil, http.StatusUnauthorized},
+		{"wrong method", func() *http.Request {
+			return httptest.NewRequest("GET", "https://example.com/fulfillment_order_notification", nil)
+		}, nil, http.StatusMethodNotAllowed},
+		{"invalid json", func() *http.Request {
+			return newWebhookRequest("", `{"kind":`)
+		}, nil, http.StatusBadRequest},
+		{"callback error", func() *http.Request {
+			return newWebhookRequest("", `{"kind":"CANCELLATION_REQUEST"}`)
+		}, errors.New("test-error"), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		callbacks.err = c.err
+		req := c.request()
+		req.URL.Path = "/fulfillment_order_notification.json"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != c.status {
+			t.Errorf("FulfillmentServiceHandler (%s) returned status %d, expected %d", c.description, rec.Code, c.status)
+		}
+	}
+}
+
+// signFetchURL adds the hmac Shopify signs fetch requests with to the URL.
+func signFetchURL(app App, rawURL string) string {
+	u, _ := url.Parse(rawURL)
+	query := u.Query()
+	message, _ := url.QueryUnescape(query.Encode())
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write([]byte(message))
+	query.Set("hmac", hex.EncodeToString(mac.Sum(nil)))
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func TestFulfillmentServiceHandlerFetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	callbacks := new(testFulfillmentServiceCallbacks)
+	handler := NewFulfillmentServiceHandler(app, callbacks)
+
+	cases := []struct {
+		url          string
+		expectedBody string
+	}{
+		{
+			"https://example.com/fulfillment/fetch_tracking_numbers.json?order_names[]=%231001.1&order_names[]=%231002.1&shop=fooshop.myshopify.com",
+			`{"tracking_numbers":{"#1001.1":"TRACK1001.1","#1002.1":"TRACK1002.1"},"success":true}`,
+		},
+		{
+			"https://example.com/fulfillment/fetch_stock.json?sku=IPOD2008GREEN&shop=fooshop.myshopify.com",
+			`{"IPOD2008GREEN":5}`,
+		},
+		{
+			"https://example.com/fulfillment/fetch_stock?shop=fooshop.myshopify.com",
+			`{"IPOD2008GREEN":5,"IPOD2008RED":0}`,
+		},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", signFetchURL(app, c.url), nil))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("FulfillmentServiceHandler(%s) returned status %d, expected %d", c.url, rec.Code, http.StatusOK)
+		}
+		if body := strings.TrimSpace(rec.Body.String()); body != c.expectedBody {
+			t.Errorf("FulfillmentServiceHandler(%s) returned %s, expected %s", c.url, body, c.expectedBody)
+		}
+		if rec.Header().Get("Content-Type") != "application/json" || callbacks.shop != "fooshop.myshopify.com" {
+			t.Errorf("FulfillmentServiceHandler(%s) returned content type %s for shop %s", c.url, rec.Header().Get("Content-Type"), callbacks.shop)
+		}
+	}
+
+	// the shop parameter isn't trusted without a valid hmac
+	for _, u := range []string{
+		"https://example.com/fetch_stock?shop=barshop.myshopify.com",
+		strings.Replace(signFetchURL(app, "https://example.com/fetch_tracking_numbers?order_names[]=%231001.1&shop=fooshop.myshopify.com"), "fooshop", "barshop", 1),
+	} {
+		callbacks.shop = ""
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", u, nil))
+		if rec.Code != http.StatusUnauthorized || callbacks.shop != "" {
+			t.Errorf("FulfillmentServiceHandler(%s) returned status %d for shop %s, expected %d", u, rec.Code, callbacks.shop, http.StatusUnauthorized)
+		}
+	}
+
+	callbacks.err = errors.New("test-error")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", signFetchURL(app, "https://example.com/fetch_stock?shop=fooshop.myshopify.com"), nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("FulfillmentServiceHandler returned status %d, expected %d", rec.Code, http.StatusInternalServerError)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "https://example.com/unknown", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("FulfillmentServiceHandler returned status %d, expected %d", rec.Code, http.StatusNotFound)
+	}
+}
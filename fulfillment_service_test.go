@@ -0,0 +1,148 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentServiceTests(t *testing.T, fulfillmentService *FulfillmentServiceData) {
+	if fulfillmentService == nil {
+		t.Fatal("FulfillmentService is nil")
+	}
+
+	enabled, disabled := true, false
+	expected := &FulfillmentServiceData{
+		ID:                     1061774487,
+		Name:                   "Jupiter Fulfillment",
+		Email:                  "aaa@gmail.com",
+		ServiceName:            "Jupiter Fulfillment",
+		Handle:                 "jupiter-fulfillment",
+		LocationID:             1072404542,
+		CallbackURL:            "http://google.com/",
+		Format:                 "json",
+		InventoryManagement:    &enabled,
+		TrackingSupport:        &enabled,
+		RequiresShippingMethod: &enabled,
+		FulfillmentOrdersOptIn: &enabled,
+		IncludePendingStock:    &disabled,
+		PermitsSkuSharing:      &disabled,
+		AdminGraphqlAPIID:      "gid://shopify/ApiFulfillmentService/1061774487",
+	}
+	if !reflect.DeepEqual(fulfillmentService, expected) {
+		t.Errorf("FulfillmentService returned %+v, expected %+v", fulfillmentService, expected)
+	}
+}
+
+func TestFulfillmentServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services.json", client.pathPrefix),
+		map[string]string{"scope": "all"},
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_services.json")))
+
+	fulfillmentServices, err := client.FulfillmentServiceService.List(FulfillmentServiceOptions{Scope: FulfillmentServiceScopeAll})
+	if err != nil {
+		t.Errorf("FulfillmentService.List returned error: %v", err)
+	}
+
+	if len(fulfillmentServices) != 2 {
+		t.Fatalf("FulfillmentService.List returned %d fulfillment services, expected 2", len(fulfillmentServices))
+	}
+	fulfillmentServiceTests(t, &fulfillmentServices[0])
+}
+
+func TestFulfillmentServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentServiceService.Get(1061774487, nil)
+	if err != nil {
+		t.Errorf("FulfillmentService.Get returned error: %v", err)
+	}
+
+	fulfillmentServiceTests(t, fulfillmentService)
+}
+
+func TestFulfillmentServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("fulfillment_service.json")), nil
+		})
+
+	enabled := true
+	fulfillmentService, err := client.FulfillmentServiceService.Create(FulfillmentServiceData{
+		Name:                   "Jupiter Fulfillment",
+		CallbackURL:            "http://google.com/",
+		Format:                 "json",
+		InventoryManagement:    &enabled,
+		TrackingSupport:        &enabled,
+		RequiresShippingMethod: &enabled,
+		FulfillmentOrdersOptIn: &enabled,
+	})
+	if err != nil {
+		t.Errorf("FulfillmentService.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"fulfillment_service":{"name":"Jupiter Fulfillment","callback_url":"http://google.com/","format":"json",` +
+		`"inventory_management":true,"tracking_support":true,"requires_shipping_method":true,"fulfillment_orders_opt_in":true}}`
+	if body != expectedBody {
+		t.Errorf("FulfillmentService.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	fulfillmentServiceTests(t, fulfillmentService)
+}
+
+func TestFulfillmentServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("fulfillment_service.json")), nil
+		})
+
+	disabled := false
+	fulfillmentService, err := client.FulfillmentServiceService.Update(FulfillmentServiceData{ID: 1061774487, Name: "Jupiter Fulfillment", PermitsSkuSharing: &disabled})
+	if err != nil {
+		t.Errorf("FulfillmentService.Update returned error: %v", err)
+	}
+
+	// settings that aren't set are left unchanged
+	expectedBody := `{"fulfillment_service":{"id":1061774487,"name":"Jupiter Fulfillment","permits_sku_sharing":false}}`
+	if body != expectedBody {
+		t.Errorf("FulfillmentService.Update sent %s, expected %s", body, expectedBody)
+	}
+
+	fulfillmentServiceTests(t, fulfillmentService)
+}
+
+func TestFulfillmentServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentServiceService.Delete(1061774487)
+	if err != nil {
+		t.Errorf("FulfillmentService.Delete returned error: %v", err)
+	}
+}
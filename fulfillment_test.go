@@ -2,6 +2,8 @@ package goshopify
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -194,3 +196,77 @@ func TestFulfillmentCancel(t *testing.T) {
 
 	FulfillmentTests(t, *returnedFulfillment)
 }
+
+func TestFulfillmentCreateForFulfillmentOrders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillments.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ = ioutil.ReadAll(req.Body)
+			return httpmock.NewBytesResponse(201, loadFixture("fulfillment.json")), nil
+		})
+
+	fulfillment := Fulfillment{
+		LineItemsByFulfillmentOrder: []FulfillmentOrderLineItems{
+			{
+				FulfillmentOrderID: 1046000778,
+				FulfillmentOrderLineItems: []FulfillmentOrderLineItemQuantity{
+					{ID: 1058737482, Quantity: 1},
+				},
+			},
+		},
+		TrackingInfo: &FulfillmentTrackingInfo{
+			Number:  "MS1562678",
+			URL:     "https://www.my-shipping-company.com?tracking_number=MS1562678",
+			Company: "Jack Black's Pack, Stack and Track",
+		},
+		NotifyCustomer: true,
+	}
+
+	returnedFulfillment, err := client.Fulfillment.Create(fulfillment)
+	if err != nil {
+		t.Fatalf("Fulfillment.Create returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *returnedFulfillment)
+
+	expectedBody := `{"fulfillment":{"receipt":{},"notify_customer":true,` +
+		`"line_items_by_fulfillment_order":[{"fulfillment_order_id":1046000778,"fulfillment_order_line_items":[{"id":1058737482,"quantity":1}]}],` +
+		`"tracking_info":{"number":"MS1562678","url":"https://www.my-shipping-company.com?tracking_number=MS1562678","company":"Jack Black's Pack, Stack and Track"}}}`
+	if string(body) != expectedBody {
+		t.Errorf("Fulfillment.Create sent %s, expected %s", body, expectedBody)
+	}
+}
+
+func TestFulfillmentUpdateTracking(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillments/1022782888/update_tracking.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ = ioutil.ReadAll(req.Body)
+			return httpmock.NewBytesResponse(200, loadFixture("fulfillment.json")), nil
+		})
+
+	fulfillmentService := &FulfillmentServiceOp{client: client, resource: ordersResourceName, resourceID: 123}
+
+	trackingInfo := FulfillmentTrackingInfo{
+		Number:  "1111",
+		URL:     "http://www.my-url.com",
+		Company: "my-company",
+	}
+	returnedFulfillment, err := fulfillmentService.UpdateTracking(1022782888, trackingInfo, true)
+	if err != nil {
+		t.Fatalf("Fulfillment.UpdateTracking returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *returnedFulfillment)
+
+	expectedBody := `{"fulfillment":{"notify_customer":true,"tracking_info":{"number":"1111","url":"http://www.my-url.com","company":"my-company"}}}`
+	if string(body) != expectedBody {
+		t.Errorf("Fulfillment.UpdateTracking sent %s, expected %s", body, expectedBody)
+	}
+}
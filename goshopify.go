@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -82,8 +83,14 @@ type Client struct {
 	retries  int
 	attempts int
 
+	// RateLimits is updated after every request. When the client is shared
+	// between goroutines, read it with CurrentRateLimits instead.
 	RateLimits RateLimitInfo
 
+	// guards attempts, apiVersion and RateLimits so that the client can be
+	// shared between goroutines
+	mu sync.Mutex
+
 	// Services used for communicating with the API
 	Product                    ProductService
 	CustomCollection           CustomCollectionService
@@ -92,12 +99,20 @@ type Client struct {
 	CustomerAddress            CustomerAddressService
 	Order                      OrderService
 	Fulfillment                FulfillmentService
+	FulfillmentOrder           FulfillmentOrderService
+	FulfillmentServiceService  FulfillmentServiceService
+	FulfillmentEvent           FulfillmentEventService
 	DraftOrder                 DraftOrderService
 	Shop                       ShopService
 	Webhook                    WebhookService
 	Variant                    VariantService
 	Image                      ImageService
 	Transaction                TransactionService
+	TenderTransaction          TenderTransactionService
+	Refund                     RefundService
+	OrderRisk                  OrderRiskService
+	OrderEdit                  OrderEditService
+	Return                     ReturnService
 	Theme                      ThemeService
 	Asset                      AssetService
 	ScriptTag                  ScriptTagService
@@ -114,7 +129,10 @@ type Client struct {
 	DiscountCode               DiscountCodeService
 	PriceRule                  PriceRuleService
 	InventoryItem              InventoryItemService
+	InventoryLevel             InventoryLevelService
+	Location                   LocationService
 	ShippingZone               ShippingZoneService
+	CarrierService             CarrierServiceService
 	ProductListing             ProductListingService
 	Currency                   CurrencyService
 }
@@ -278,12 +296,20 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 	c.CustomerAddress = &CustomerAddressServiceOp{client: c}
 	c.Order = &OrderServiceOp{client: c}
 	c.Fulfillment = &FulfillmentServiceOp{client: c}
+	c.FulfillmentOrder = &FulfillmentOrderServiceOp{client: c}
+	c.FulfillmentServiceService = &FulfillmentServiceServiceOp{client: c}
+	c.FulfillmentEvent = &FulfillmentEventServiceOp{client: c}
 	c.DraftOrder = &DraftOrderServiceOp{client: c}
 	c.Shop = &ShopServiceOp{client: c}
 	c.Webhook = &WebhookServiceOp{client: c}
 	c.Variant = &VariantServiceOp{client: c}
 	c.Image = &ImageServiceOp{client: c}
 	c.Transaction = &TransactionServiceOp{client: c}
+	c.TenderTransaction = &TenderTransactionServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.OrderRisk = &OrderRiskServiceOp{client: c}
+	c.OrderEdit = &OrderEditServiceOp{client: c}
+	c.Return = &ReturnServiceOp{client: c}
 	c.Theme = &ThemeServiceOp{client: c}
 	c.Asset = &AssetServiceOp{client: c}
 	c.ScriptTag = &ScriptTagServiceOp{client: c}
@@ -300,7 +326,10 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 	c.DiscountCode = &DiscountCodeServiceOp{client: c}
 	c.PriceRule = &PriceRuleServiceOp{client: c}
 	c.InventoryItem = &InventoryItemServiceOp{client: c}
+	c.InventoryLevel = &InventoryLevelServiceOp{client: c}
+	c.Location = &LocationServiceOp{client: c}
 	c.ShippingZone = &ShippingZoneServiceOp{client: c}
+	c.CarrierService = &CarrierServiceServiceOp{client: c}
 	c.ProductListing = &ProductListingServiceOp{client: c}
 	c.Currency = &CurrencyServiceOp{client: c}
 
@@ -329,11 +358,17 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	var resp *http.Response
 	var err error
 	retries := c.retries
-	c.attempts = 0
+	attempts := 0
 	c.logRequest(req)
 
+	defer func() {
+		c.mu.Lock()
+		c.attempts = attempts
+		c.mu.Unlock()
+	}()
+
 	for {
-		c.attempts++
+		attempts++
 		resp, err = c.Client.Do(req)
 		c.logResponse(resp)
 		if err != nil {
@@ -381,11 +416,13 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	c.logResponse(resp)
 	defer resp.Body.Close()
 
+	c.mu.Lock()
 	if c.apiVersion == defaultApiVersion && resp.Header.Get("X-Shopify-API-Version") != "" {
 		// if using stable on first request set the api version
 		c.apiVersion = resp.Header.Get("X-Shopify-API-Version")
 		c.log.Infof("api version not set, now using %s", c.apiVersion)
 	}
+	c.mu.Unlock()
 
 	if v != nil {
 		decoder := json.NewDecoder(resp.Body)
@@ -395,6 +432,9 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		}
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if s := strings.Split(resp.Header.Get("X-Shopify-Shop-Api-Call-Limit"), "/"); len(s) == 2 {
 		c.RateLimits.RequestCount, _ = strconv.Atoi(s[0])
 		c.RateLimits.BucketSize, _ = strconv.Atoi(s[1])
@@ -405,6 +445,14 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	return resp.Header, nil
 }
 
+// CurrentRateLimits returns the rate limits reported by the last response.
+// Unlike reading RateLimits, it is safe while other goroutines use the client.
+func (c *Client) CurrentRateLimits() RateLimitInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.RateLimits
+}
+
 func (c *Client) logRequest(req *http.Request) {
 	if req == nil {
 		return
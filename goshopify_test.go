@@ -894,6 +894,8 @@ func TestDoRateLimit(t *testing.T) {
 				}
 			} else if err == nil && !reflect.DeepEqual(client.RateLimits, c.expected) {
 				t.Errorf("%s: expected %#v, actual %#v", c.description, c.expected, client.RateLimits)
+			} else if err == nil && !reflect.DeepEqual(client.CurrentRateLimits(), c.expected) {
+				t.Errorf("%s: CurrentRateLimits expected %#v, actual %#v", c.description, c.expected, client.CurrentRateLimits())
 			}
 		})
 	}
@@ -0,0 +1,144 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const graphQLPath = "graphql.json"
+
+// graphQLEndpoint returns the path of the GraphQL endpoint relative to the
+// path prefix. Without an API version the prefix is admin, but GraphQL is only
+// served under admin/api.
+func (c *Client) graphQLEndpoint() string {
+	if c.pathPrefix == defaultApiPathPrefix {
+		return "api/" + graphQLPath
+	}
+	return graphQLPath
+}
+
+// graphQLResponse represents the result from the graphql.json endpoint
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GraphQLUserError is an error in the input of a GraphQL mutation. Field is
+// the path to the input field, if any. Only some mutations return a Code.
+type GraphQLUserError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+	Code    string   `json:"code,omitempty"`
+}
+
+// GraphQLMutationError is returned when Shopify rejects a GraphQL mutation
+// with user errors
+type GraphQLMutationError struct {
+	Mutation   string
+	UserErrors []GraphQLUserError
+}
+
+func (e GraphQLMutationError) Error() string {
+	messages := make([]string, 0, len(e.UserErrors))
+	for _, userError := range e.UserErrors {
+		if len(userError.Field) > 0 {
+			messages = append(messages, fmt.Sprintf("%s: %s", strings.Join(userError.Field, "."), userError.Message))
+		} else {
+			messages = append(messages, userError.Message)
+		}
+	}
+	return fmt.Sprintf("%s failed: %s", e.Mutation, strings.Join(messages, ", "))
+}
+
+// graphQLID returns the global ID of a REST resource
+func graphQLID(resource string, id int64) string {
+	return fmt.Sprintf("gid://shopify/%s/%d", resource, id)
+}
+
+// legacyResourceID returns the REST ID of a global ID
+func legacyResourceID(gid string) (int64, error) {
+	if i := strings.IndexByte(gid, '?'); i >= 0 {
+		gid = gid[:i]
+	}
+	id, err := strconv.ParseInt(gid[strings.LastIndexByte(gid, '/')+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid global ID %q", gid)
+	}
+	return id, nil
+}
+
+// unmarshalConnection saves the nodes of a GraphQL connection in the given
+// slice
+func unmarshalConnection(data []byte, nodes interface{}) error {
+	connection := struct {
+		Edges []struct {
+			Node json.RawMessage `json:"node"`
+		} `json:"edges"`
+	}{}
+	if err := json.Unmarshal(data, &connection); err != nil {
+		return err
+	}
+
+	raw := make([]json.RawMessage, 0, len(connection.Edges))
+	for _, edge := range connection.Edges {
+		raw = append(raw, edge.Node)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, nodes)
+}
+
+// graphQLPageInfo is the pageInfo of a GraphQL connection
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// unmarshalConnectionPage saves the nodes of a page of a GraphQL connection
+// in the given slice and returns the page info, which the query must select
+func unmarshalConnectionPage(data []byte, nodes interface{}) (graphQLPageInfo, error) {
+	page := struct {
+		PageInfo graphQLPageInfo `json:"pageInfo"`
+	}{}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return page.PageInfo, err
+	}
+	return page.PageInfo, unmarshalConnection(data, nodes)
+}
+
+// graphQL runs a query or mutation against the Admin GraphQL API and saves
+// its data in the given resource. Top level errors of the response, which
+// Shopify returns with a 200 status, are returned as a ResponseError.
+func (c *Client) graphQL(query string, variables, resource interface{}) error {
+	body := struct {
+		Query     string      `json:"query"`
+		Variables interface{} `json:"variables,omitempty"`
+	}{query, variables}
+
+	response := new(graphQLResponse)
+	err := c.Post(c.graphQLEndpoint(), body, response)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		responseError := ResponseError{Status: http.StatusOK}
+		for _, e := range response.Errors {
+			responseError.Errors = append(responseError.Errors, e.Message)
+		}
+		responseError.Message = responseError.Errors[0]
+		return responseError
+	}
+
+	if resource == nil || len(response.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(response.Data, resource)
+}
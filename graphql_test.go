@@ -0,0 +1,140 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// registerGraphQLResponder responds to GraphQL requests with the fixture
+// and saves the request in request
+func registerGraphQLResponder(request *graphQLRequest, fixture string) {
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(b, request)
+			return httpmock.NewBytesResponse(200, loadFixture(fixture)), nil
+		})
+}
+
+func TestGraphQLID(t *testing.T) {
+	id := graphQLID("Order", 450789469)
+	if id != "gid://shopify/Order/450789469" {
+		t.Errorf("graphQLID returned %s", id)
+	}
+}
+
+func TestLegacyResourceID(t *testing.T) {
+	cases := []struct {
+		gid      string
+		expected int64
+	}{
+		{"gid://shopify/Order/450789469", 450789469},
+		{"gid://shopify/InventoryLevel/4688969785?inventory_item_id=808950810", 4688969785},
+	}
+
+	for _, c := range cases {
+		id, err := legacyResourceID(c.gid)
+		if err != nil || id != c.expected {
+			t.Errorf("legacyResourceID(%s) returned %d, %v, expected %d", c.gid, id, err, c.expected)
+		}
+	}
+
+	if _, err := legacyResourceID("gid://shopify/CalculatedLineItem/a1b2c3d4"); err == nil {
+		t.Error("legacyResourceID returned no error for a non numeric ID")
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(200, `{"data":{"shop":{"name":"fooshop"}}}`), nil
+		})
+
+	resource := struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}{}
+	variables := struct {
+		ID string `json:"id"`
+	}{"gid://shopify/Shop/1"}
+	err := client.graphQL("query shop($id: ID!) { shop { name } }", variables, &resource)
+	if err != nil {
+		t.Errorf("Client.graphQL returned error: %v", err)
+	}
+
+	expectedBody := `{"query":"query shop($id: ID!) { shop { name } }","variables":{"id":"gid://shopify/Shop/1"}}`
+	if body != expectedBody {
+		t.Errorf("Client.graphQL sent %s, expected %s", body, expectedBody)
+	}
+	if resource.Shop.Name != "fooshop" {
+		t.Errorf("Client.graphQL returned %+v", resource)
+	}
+}
+
+func TestGraphQLWithoutVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd")
+	httpmock.ActivateNonDefault(client.Client)
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/api/graphql.json",
+		httpmock.NewStringResponder(200, `{"data":{"shop":{"name":"fooshop"}}}`))
+
+	err := client.graphQL("{ shop { name } }", nil, nil)
+	if err != nil {
+		t.Errorf("Client.graphQL without an API version returned error: %v", err)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"errors":[{"message":"Field 'foo' doesn't exist on type 'QueryRoot'"},{"message":"Throttled"}]}`))
+
+	err := client.graphQL("{ foo }", nil, nil)
+
+	expected := ResponseError{
+		Status:  200,
+		Message: "Field 'foo' doesn't exist on type 'QueryRoot'",
+		Errors:  []string{"Field 'foo' doesn't exist on type 'QueryRoot'", "Throttled"},
+	}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("Client.graphQL returned error %#v, expected %#v", err, expected)
+	}
+}
+
+func TestGraphQLMutationError(t *testing.T) {
+	err := GraphQLMutationError{
+		Mutation: "orderEditAddVariant",
+		UserErrors: []GraphQLUserError{
+			{Field: []string{"variantId"}, Message: "Variant does not exist", Code: "NOT_FOUND"},
+			{Message: "Access denied"},
+		},
+	}
+
+	expected := "orderEditAddVariant failed: variantId: Variant does not exist, Access denied"
+	if err.Error() != expected {
+		t.Errorf("GraphQLMutationError.Error returned %s, expected %s", err.Error(), expected)
+	}
+}
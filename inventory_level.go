@@ -0,0 +1,195 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const inventoryLevelsBasePath = "inventory_levels"
+
+// InventoryLevelService is an interface for interacting with the
+// inventory levels endpoints of the Shopify API
+// See https://help.shopify.com/en/api/reference/inventory/inventorylevel
+type InventoryLevelService interface {
+	List(interface{}) ([]InventoryLevel, error)
+	ListWithPagination(interface{}) ([]InventoryLevel, *Pagination, error)
+	Adjust(InventoryLevelAdjustOptions) (*InventoryLevel, error)
+	Set(InventoryLevelSetOptions) (*InventoryLevel, error)
+	Connect(InventoryLevelConnectOptions) (*InventoryLevel, error)
+	Delete(int64, int64) error
+}
+
+// InventoryLevelServiceOp is the default implementation of the InventoryLevelService interface
+type InventoryLevelServiceOp struct {
+	client *Client
+}
+
+// InventoryLevel represents the quantity of an inventory item available at a
+// location.
+type InventoryLevel struct {
+	InventoryItemID   int64      `json:"inventory_item_id,omitempty"`
+	LocationID        int64      `json:"location_id,omitempty"`
+	Available         *int       `json:"available"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
+}
+
+// InventoryLevelResource is used for handling single level requests and responses
+type InventoryLevelResource struct {
+	InventoryLevel *InventoryLevel `json:"inventory_level"`
+}
+
+// InventoryLevelsResource is used for handling multiple level responses
+type InventoryLevelsResource struct {
+	InventoryLevels []InventoryLevel `json:"inventory_levels"`
+}
+
+// InventoryLevelListOptions is used to filter the inventory levels on a List
+// request. At least one inventory item or location ID is required.
+type InventoryLevelListOptions struct {
+	PageInfo         string    `url:"page_info,omitempty"`
+	Limit            int       `url:"limit,omitempty"`
+	InventoryItemIDs []int64   `url:"inventory_item_ids,omitempty,comma"`
+	LocationIDs      []int64   `url:"location_ids,omitempty,comma"`
+	UpdatedAtMin     time.Time `url:"updated_at_min,omitempty"`
+}
+
+// InventoryLevelAdjustOptions adjusts the available quantity of an inventory
+// item at a location by AvailableAdjustment, which can be negative.
+type InventoryLevelAdjustOptions struct {
+	InventoryItemID     int64 `json:"inventory_item_id"`
+	LocationID          int64 `json:"location_id"`
+	AvailableAdjustment int   `json:"available_adjustment"`
+}
+
+// InventoryLevelSetOptions sets the available quantity of an inventory item
+// at a location. If DisconnectIfNecessary is true the item is disconnected
+// from any fulfillment service location that would prevent stocking it here.
+type InventoryLevelSetOptions struct {
+	InventoryItemID       int64 `json:"inventory_item_id"`
+	LocationID            int64 `json:"location_id"`
+	Available             int   `json:"available"`
+	DisconnectIfNecessary bool  `json:"disconnect_if_necessary,omitempty"`
+}
+
+// InventoryLevelConnectOptions connects an inventory item to a location. If
+// RelocateIfNecessary is true the item is disconnected from any fulfillment
+// service location it is stocked at.
+type InventoryLevelConnectOptions struct {
+	InventoryItemID     int64 `json:"inventory_item_id"`
+	LocationID          int64 `json:"location_id"`
+	RelocateIfNecessary bool  `json:"relocate_if_necessary,omitempty"`
+}
+
+type inventoryLevelDeleteOptions struct {
+	InventoryItemID int64 `url:"inventory_item_id"`
+	LocationID      int64 `url:"location_id"`
+}
+
+// InventoryItemNotStockedError occurs when changing the inventory of an item
+// at a location it isn't connected to. Use Connect first, or Set with
+// DisconnectIfNecessary.
+type InventoryItemNotStockedError struct {
+	ResponseError
+	InventoryItemID int64
+	LocationID      int64
+}
+
+// InventoryItemNotTrackedError occurs when changing the inventory of an item
+// that doesn't have inventory tracking enabled.
+type InventoryItemNotTrackedError struct {
+	ResponseError
+	InventoryItemID int64
+}
+
+// List inventory levels
+func (s *InventoryLevelServiceOp) List(options interface{}) ([]InventoryLevel, error) {
+	levels, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+// ListWithPagination lists inventory levels and return pagination to retrieve next/previous results.
+func (s *InventoryLevelServiceOp) ListWithPagination(options interface{}) ([]InventoryLevel, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.InventoryLevels, pagination, nil
+}
+
+// Adjust the available quantity of an inventory item at a location
+func (s *InventoryLevelServiceOp) Adjust(options InventoryLevelAdjustOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/adjust.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, wrapInventoryLevelError(err, options.InventoryItemID, options.LocationID)
+}
+
+// Set the available quantity of an inventory item at a location
+func (s *InventoryLevelServiceOp) Set(options InventoryLevelSetOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/set.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, wrapInventoryLevelError(err, options.InventoryItemID, options.LocationID)
+}
+
+// Connect an inventory item to a location
+func (s *InventoryLevelServiceOp) Connect(options InventoryLevelConnectOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/connect.json", inventoryLevelsBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, wrapInventoryLevelError(err, options.InventoryItemID, options.LocationID)
+}
+
+// Delete the inventory level of an inventory item at a location, disconnecting
+// the item from the location
+func (s *InventoryLevelServiceOp) Delete(inventoryItemID int64, locationID int64) error {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	options := inventoryLevelDeleteOptions{InventoryItemID: inventoryItemID, LocationID: locationID}
+	err := s.client.CreateAndDo("DELETE", path, nil, options, nil)
+	return wrapInventoryLevelError(err, inventoryItemID, locationID)
+}
+
+// wrapInventoryLevelError turns the common 422 responses of the inventory
+// level endpoints into typed errors.
+func wrapInventoryLevelError(err error, inventoryItemID int64, locationID int64) error {
+	responseErr, ok := err.(ResponseError)
+	if !ok || responseErr.Status != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	message := strings.ToLower(responseErr.Error())
+	switch {
+	case strings.Contains(message, "not stocked"):
+		return InventoryItemNotStockedError{
+			ResponseError:   responseErr,
+			InventoryItemID: inventoryItemID,
+			LocationID:      locationID,
+		}
+	case strings.Contains(message, "tracking"):
+		return InventoryItemNotTrackedError{
+			ResponseError:   responseErr,
+			InventoryItemID: inventoryItemID,
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,219 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestInventoryLevelList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{
+		"inventory_item_ids": "808950810,39072856",
+		"location_ids":       "4688969785",
+	}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix),
+		params,
+		httpmock.NewBytesResponder(200, loadFixture("inventory_levels.json")))
+
+	options := InventoryLevelListOptions{
+		InventoryItemIDs: []int64{808950810, 39072856},
+		LocationIDs:      []int64{4688969785},
+	}
+	levels, err := client.InventoryLevel.List(options)
+	if err != nil {
+		t.Errorf("InventoryLevel.List returned error: %v", err)
+	}
+
+	if len(levels) != 2 {
+		t.Fatalf("InventoryLevel.List returned %d levels, expected 2", len(levels))
+	}
+	if levels[0].Available == nil || *levels[0].Available != 27 {
+		t.Errorf("InventoryLevel.List returned available %v, expected 27", levels[0].Available)
+	}
+	if levels[1].Available != nil {
+		t.Errorf("InventoryLevel.List returned available %v, expected nil", *levels[1].Available)
+	}
+}
+
+func TestInventoryLevelListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"inventory_levels": [{"inventory_item_id":1},{"inventory_item_id":2}]}`)
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	levels, pagination, err := client.InventoryLevel.ListWithPagination(InventoryLevelListOptions{LocationIDs: []int64{1}, Limit: 2})
+	if err != nil {
+		t.Fatalf("InventoryLevel.ListWithPagination returned error: %v", err)
+	}
+
+	expectedLevels := []InventoryLevel{{InventoryItemID: 1}, {InventoryItemID: 2}}
+	if !reflect.DeepEqual(levels, expectedLevels) {
+		t.Errorf("InventoryLevel.ListWithPagination returned %+v, expected %+v", levels, expectedLevels)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "foo", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("InventoryLevel.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestInventoryLevelAdjust(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/adjust.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("inventory_level.json")), nil
+		})
+
+	level, err := client.InventoryLevel.Adjust(InventoryLevelAdjustOptions{
+		InventoryItemID:     808950810,
+		LocationID:          905684977,
+		AvailableAdjustment: -2,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Adjust returned error: %v", err)
+	}
+
+	expectedBody := `{"inventory_item_id":808950810,"location_id":905684977,"available_adjustment":-2}`
+	if body != expectedBody {
+		t.Errorf("InventoryLevel.Adjust sent %s, expected %s", body, expectedBody)
+	}
+
+	if level == nil || level.Available == nil || *level.Available != 6 || level.LocationID != 905684977 {
+		t.Errorf("InventoryLevel.Adjust returned %+v", level)
+	}
+}
+
+func TestInventoryLevelSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/set.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("inventory_level.json")), nil
+		})
+
+	level, err := client.InventoryLevel.Set(InventoryLevelSetOptions{
+		InventoryItemID:       808950810,
+		LocationID:            905684977,
+		Available:             6,
+		DisconnectIfNecessary: true,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Set returned error: %v", err)
+	}
+
+	expectedBody := `{"inventory_item_id":808950810,"location_id":905684977,"available":6,"disconnect_if_necessary":true}`
+	if body != expectedBody {
+		t.Errorf("InventoryLevel.Set sent %s, expected %s", body, expectedBody)
+	}
+
+	if level == nil || level.Available == nil || *level.Available != 6 {
+		t.Errorf("InventoryLevel.Set returned %+v", level)
+	}
+}
+
+func TestInventoryLevelConnect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/connect.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"inventory_level":{"inventory_item_id":808950810,"location_id":905684977,"available":0}}`), nil
+		})
+
+	level, err := client.InventoryLevel.Connect(InventoryLevelConnectOptions{
+		InventoryItemID: 808950810,
+		LocationID:      905684977,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Connect returned error: %v", err)
+	}
+
+	expectedBody := `{"inventory_item_id":808950810,"location_id":905684977}`
+	if body != expectedBody {
+		t.Errorf("InventoryLevel.Connect sent %s, expected %s", body, expectedBody)
+	}
+
+	if level == nil || level.Available == nil || *level.Available != 0 {
+		t.Errorf("InventoryLevel.Connect returned %+v", level)
+	}
+}
+
+func TestInventoryLevelDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{
+		"inventory_item_id": "808950810",
+		"location_id":       "905684977",
+	}
+	httpmock.RegisterResponderWithQuery("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.InventoryLevel.Delete(808950810, 905684977)
+	if err != nil {
+		t.Errorf("InventoryLevel.Delete returned error: %v", err)
+	}
+}
+
+func TestInventoryLevelErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	adjustURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/adjust.json", client.pathPrefix)
+	options := InventoryLevelAdjustOptions{InventoryItemID: 808950810, LocationID: 905684977, AvailableAdjustment: 1}
+
+	httpmock.RegisterResponder("POST", adjustURL,
+		httpmock.NewStringResponder(422, `{"errors":["Inventory item is not stocked at the location"]}`))
+
+	_, err := client.InventoryLevel.Adjust(options)
+	notStocked, ok := err.(InventoryItemNotStockedError)
+	if !ok {
+		t.Fatalf("InventoryLevel.Adjust returned %T %v, expected InventoryItemNotStockedError", err, err)
+	}
+	if notStocked.InventoryItemID != 808950810 || notStocked.LocationID != 905684977 || notStocked.Status != 422 {
+		t.Errorf("InventoryLevel.Adjust returned %+v", notStocked)
+	}
+
+	httpmock.RegisterResponder("POST", adjustURL,
+		httpmock.NewStringResponder(422, `{"errors":["Inventory item does not have inventory tracking enabled"]}`))
+
+	_, err = client.InventoryLevel.Adjust(options)
+	if _, ok := err.(InventoryItemNotTrackedError); !ok {
+		t.Errorf("InventoryLevel.Adjust returned %T %v, expected InventoryItemNotTrackedError", err, err)
+	}
+
+	httpmock.RegisterResponder("POST", adjustURL,
+		httpmock.NewStringResponder(422, `{"errors":{"available_adjustment":["is invalid"]}}`))
+
+	_, err = client.InventoryLevel.Adjust(options)
+	if _, ok := err.(ResponseError); !ok {
+		t.Errorf("InventoryLevel.Adjust returned %T %v, expected ResponseError", err, err)
+	}
+}
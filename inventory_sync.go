@@ -0,0 +1,394 @@
+package goshopify
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// Shopify accepts up to 250 results per page and 50 ids per filter on the
+	// inventory endpoints
+	inventorySyncPageSize  = 250
+	inventorySyncChunkSize = 50
+)
+
+// Reasons a SKU of the desired inventory can't be synced
+const (
+	InventoryConflictUnknownSKU   = "unknown_sku"
+	InventoryConflictDuplicateSKU = "duplicate_sku"
+	InventoryConflictUntracked    = "untracked"
+)
+
+// DesiredInventory is the stock an external source of truth expects, as
+// available quantities by SKU and location ID.
+type DesiredInventory map[string]map[int64]int
+
+// InventoryChange is a change to the available quantity of an inventory item
+// at a location. Items already stocked at the location are adjusted by
+// Adjustment, items not stocked there yet are set to Desired, connecting them
+// to the location.
+type InventoryChange struct {
+	SKU             string
+	InventoryItemID int64
+	LocationID      int64
+	Stocked         bool
+	Current         int
+	Desired         int
+	Adjustment      int
+}
+
+// InventoryConflict is a SKU that is skipped because it doesn't resolve to a
+// single tracked inventory item.
+type InventoryConflict struct {
+	SKU        string
+	Reason     string
+	VariantIDs []int64
+}
+
+// InventoryFailure is a change that couldn't be applied.
+type InventoryFailure struct {
+	Change InventoryChange
+	Err    error
+}
+
+// InventorySyncPlan is the set of changes needed to bring the inventory of a
+// shop in line with a DesiredInventory.
+type InventorySyncPlan struct {
+	Changes   []InventoryChange
+	Conflicts []InventoryConflict
+	Unchanged int
+}
+
+// InventorySyncReport is the result of applying an InventorySyncPlan.
+type InventorySyncReport struct {
+	Changes   []InventoryChange
+	Conflicts []InventoryConflict
+	Failures  []InventoryFailure
+	Unchanged int
+}
+
+// InventorySync brings the available quantities of a shop in line with an
+// external source of truth, such as a warehouse system.
+type InventorySync struct {
+	client *Client
+
+	// Number of changes applied in parallel, defaults to 2.
+	Concurrency int
+	// Maximum number of requests per second shared by all workers, defaults
+	// to 2, the leak rate of the REST Admin API bucket.
+	RequestsPerSecond float64
+	// Number of times a change is retried after a rate limit error, defaults
+	// to 3.
+	MaxRetries int
+
+	sleep func(time.Duration)
+}
+
+// NewInventorySync returns an InventorySync using the client.
+func NewInventorySync(client *Client) *InventorySync {
+	return &InventorySync{
+		client:            client,
+		Concurrency:       2,
+		RequestsPerSecond: 2,
+		MaxRetries:        3,
+		sleep:             time.Sleep,
+	}
+}
+
+// Plan resolves the SKUs of the desired inventory to inventory items through
+// the product variants, fetches their current levels and computes the minimal
+// changes to apply.
+func (s *InventorySync) Plan(desired DesiredInventory) (*InventorySyncPlan, error) {
+	plan := new(InventorySyncPlan)
+
+	variantsBySKU, err := s.variantsBySKU(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	itemSKUs := make(map[int64]string)
+	var itemIDs []int64
+	for _, sku := range sortedSKUs(desired) {
+		variants := variantsBySKU[sku]
+		switch len(variants) {
+		case 0:
+			plan.Conflicts = append(plan.Conflicts, InventoryConflict{SKU: sku, Reason: InventoryConflictUnknownSKU})
+		case 1:
+			itemSKUs[variants[0].InventoryItemID] = sku
+			itemIDs = append(itemIDs, variants[0].InventoryItemID)
+		default:
+			conflict := InventoryConflict{SKU: sku, Reason: InventoryConflictDuplicateSKU}
+			for _, v := range variants {
+				conflict.VariantIDs = append(conflict.VariantIDs, v.ID)
+			}
+			plan.Conflicts = append(plan.Conflicts, conflict)
+		}
+	}
+
+	untracked, err := s.untrackedItems(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var trackedIDs []int64
+	for _, id := range itemIDs {
+		if untracked[id] {
+			plan.Conflicts = append(plan.Conflicts, InventoryConflict{
+				SKU:        itemSKUs[id],
+				Reason:     InventoryConflictUntracked,
+				VariantIDs: []int64{variantsBySKU[itemSKUs[id]][0].ID},
+			})
+			continue
+		}
+		trackedIDs = append(trackedIDs, id)
+	}
+
+	levels, err := s.levels(trackedIDs, desiredLocationIDs(desired))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range trackedIDs {
+		sku := itemSKUs[id]
+		for _, locationID := range sortedLocationIDs(desired[sku]) {
+			change := InventoryChange{
+				SKU:             sku,
+				InventoryItemID: id,
+				LocationID:      locationID,
+				Desired:         desired[sku][locationID],
+			}
+
+			level, ok := levels[inventoryLevelKey{id, locationID}]
+			if ok {
+				change.Stocked = true
+				if level.Available != nil {
+					change.Current = *level.Available
+				}
+			}
+
+			change.Adjustment = change.Desired - change.Current
+			// an item not stocked at a location already has nothing there,
+			// connecting it only to set zero would be a no-op
+			if (change.Stocked && change.Adjustment == 0) || (!change.Stocked && change.Desired == 0) {
+				plan.Unchanged++
+				continue
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply applies the changes of the plan with up to Concurrency workers. The
+// report lists the changes that were applied and the ones that failed, in the
+// order of the plan.
+func (s *InventorySync) Apply(plan *InventorySyncPlan) *InventorySyncReport {
+	report := &InventorySyncReport{
+		Conflicts: plan.Conflicts,
+		Unchanged: plan.Unchanged,
+	}
+
+	concurrency := s.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var throttle <-chan time.Time
+	if s.RequestsPerSecond > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / s.RequestsPerSecond))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	errs := make([]error, len(plan.Changes))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				errs[index] = s.applyChange(plan.Changes[index], throttle)
+			}
+		}()
+	}
+
+	for index := range plan.Changes {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	for index, change := range plan.Changes {
+		if errs[index] != nil {
+			report.Failures = append(report.Failures, InventoryFailure{Change: change, Err: errs[index]})
+			continue
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	return report
+}
+
+// Sync plans and applies the changes needed to reach the desired inventory.
+func (s *InventorySync) Sync(desired DesiredInventory) (*InventorySyncReport, error) {
+	plan, err := s.Plan(desired)
+	if err != nil {
+		return nil, err
+	}
+	return s.Apply(plan), nil
+}
+
+func (s *InventorySync) applyChange(change InventoryChange, throttle <-chan time.Time) error {
+	for attempt := 0; ; attempt++ {
+		if throttle != nil {
+			<-throttle
+		}
+
+		var err error
+		if change.Stocked {
+			_, err = s.client.InventoryLevel.Adjust(InventoryLevelAdjustOptions{
+				InventoryItemID:     change.InventoryItemID,
+				LocationID:          change.LocationID,
+				AvailableAdjustment: change.Adjustment,
+			})
+		} else {
+			_, err = s.client.InventoryLevel.Set(InventoryLevelSetOptions{
+				InventoryItemID: change.InventoryItemID,
+				LocationID:      change.LocationID,
+				Available:       change.Desired,
+			})
+		}
+
+		rateLimitErr, ok := err.(RateLimitError)
+		if !ok || attempt >= s.MaxRetries {
+			return err
+		}
+
+		wait := time.Duration(rateLimitErr.RetryAfter) * time.Second
+		if wait <= 0 {
+			wait = time.Second
+		}
+		s.sleep(wait)
+	}
+}
+
+// variantsBySKU pages through all products and returns their variants with a
+// SKU of the desired inventory.
+func (s *InventorySync) variantsBySKU(desired DesiredInventory) (map[string][]Variant, error) {
+	variants := make(map[string][]Variant)
+	options := &ListOptions{Limit: inventorySyncPageSize, Fields: "id,variants"}
+	for {
+		products, pagination, err := s.client.Product.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, product := range products {
+			for _, variant := range product.Variants {
+				if _, ok := desired[variant.Sku]; ok && variant.Sku != "" {
+					variants[variant.Sku] = append(variants[variant.Sku], variant)
+				}
+			}
+		}
+
+		if pagination == nil || pagination.NextPageOptions == nil {
+			return variants, nil
+		}
+		options = pagination.NextPageOptions
+		options.Fields = "id,variants"
+	}
+}
+
+// untrackedItems returns the IDs of the inventory items that don't have
+// inventory tracking enabled.
+func (s *InventorySync) untrackedItems(itemIDs []int64) (map[int64]bool, error) {
+	untracked := make(map[int64]bool)
+	for _, chunk := range chunkIDs(itemIDs, inventorySyncChunkSize) {
+		items, err := s.client.InventoryItem.List(ListOptions{IDs: chunk, Limit: inventorySyncPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if item.Tracked != nil && !*item.Tracked {
+				untracked[item.ID] = true
+			}
+		}
+	}
+	return untracked, nil
+}
+
+type inventoryLevelKey struct {
+	inventoryItemID int64
+	locationID      int64
+}
+
+// levels fetches the current levels of the inventory items at the locations.
+func (s *InventorySync) levels(itemIDs []int64, locationIDs []int64) (map[inventoryLevelKey]InventoryLevel, error) {
+	levels := make(map[inventoryLevelKey]InventoryLevel)
+	for _, chunk := range chunkIDs(itemIDs, inventorySyncChunkSize) {
+		var options interface{} = InventoryLevelListOptions{
+			Limit:            inventorySyncPageSize,
+			InventoryItemIDs: chunk,
+			LocationIDs:      locationIDs,
+		}
+		for {
+			page, pagination, err := s.client.InventoryLevel.ListWithPagination(options)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, level := range page {
+				levels[inventoryLevelKey{level.InventoryItemID, level.LocationID}] = level
+			}
+
+			if pagination == nil || pagination.NextPageOptions == nil {
+				break
+			}
+			options = pagination.NextPageOptions
+		}
+	}
+	return levels, nil
+}
+
+func chunkIDs(ids []int64, size int) [][]int64 {
+	var chunks [][]int64
+	for len(ids) > size {
+		chunks = append(chunks, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+func sortedSKUs(desired DesiredInventory) []string {
+	skus := make([]string, 0, len(desired))
+	for sku := range desired {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+	return skus
+}
+
+func sortedLocationIDs(quantities map[int64]int) []int64 {
+	ids := make([]int64, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func desiredLocationIDs(desired DesiredInventory) []int64 {
+	seen := make(map[int64]int)
+	for _, quantities := range desired {
+		for id := range quantities {
+			seen[id]++
+		}
+	}
+	return sortedLocationIDs(seen)
+}
@@ -0,0 +1,193 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func registerInventorySyncResponders() {
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("fields") != "id,variants" {
+				return httpmock.NewStringResponse(400, `{"errors":"fields missing"}`), nil
+			}
+			if req.URL.Query().Get("page_info") == "p2" {
+				return httpmock.NewStringResponse(200, `{"products":[{"id":2,"variants":[
+					{"id":21,"sku":"dup","inventory_item_id":201},
+					{"id":22,"sku":"untracked","inventory_item_id":202},
+					{"id":23,"sku":"sku-b","inventory_item_id":103}]}]}`), nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"products":[{"id":1,"variants":[
+				{"id":11,"sku":"sku-a","inventory_item_id":101},
+				{"id":12,"sku":"dup","inventory_item_id":102},
+				{"id":13,"sku":"other","inventory_item_id":104}]}]}`)
+			resp.Header.Set("Link", `<http://valid.url?page_info=p2&limit=250>; rel="next"`)
+			return resp, nil
+		})
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_items.json", client.pathPrefix),
+		map[string]string{"ids": "101,103,202", "limit": "250"},
+		httpmock.NewStringResponder(200, `{"inventory_items":[
+			{"id":101,"sku":"sku-a","tracked":true},
+			{"id":202,"sku":"untracked","tracked":false},
+			{"id":103,"sku":"sku-b","tracked":true}]}`))
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix),
+		map[string]string{"inventory_item_ids": "101,103", "location_ids": "1,2", "limit": "250"},
+		httpmock.NewStringResponder(200, `{"inventory_levels":[
+			{"inventory_item_id":101,"location_id":1,"available":7},
+			{"inventory_item_id":101,"location_id":2,"available":5}]}`))
+}
+
+func inventorySyncDesired() DesiredInventory {
+	return DesiredInventory{
+		"sku-a":     {1: 10, 2: 5},
+		"sku-b":     {1: 3},
+		"dup":       {1: 1},
+		"untracked": {1: 1},
+		"missing":   {2: 1},
+	}
+}
+
+func TestInventorySyncPlan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerInventorySyncResponders()
+
+	plan, err := NewInventorySync(client).Plan(inventorySyncDesired())
+	if err != nil {
+		t.Fatalf("InventorySync.Plan returned error: %v", err)
+	}
+
+	expected := &InventorySyncPlan{
+		Changes: []InventoryChange{
+			{SKU: "sku-a", InventoryItemID: 101, LocationID: 1, Stocked: true, Current: 7, Desired: 10, Adjustment: 3},
+			{SKU: "sku-b", InventoryItemID: 103, LocationID: 1, Desired: 3, Adjustment: 3},
+		},
+		Conflicts: []InventoryConflict{
+			{SKU: "dup", Reason: InventoryConflictDuplicateSKU, VariantIDs: []int64{12, 21}},
+			{SKU: "missing", Reason: InventoryConflictUnknownSKU},
+			{SKU: "untracked", Reason: InventoryConflictUntracked, VariantIDs: []int64{22}},
+		},
+		Unchanged: 1,
+	}
+
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("InventorySync.Plan returned %+v, expected %+v", plan, expected)
+	}
+}
+
+func TestInventorySyncPlanSkipsUnstockedZero(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerInventorySyncResponders()
+
+	desired := inventorySyncDesired()
+	desired["sku-b"][2] = 0
+	plan, err := NewInventorySync(client).Plan(desired)
+	if err != nil {
+		t.Fatalf("InventorySync.Plan returned error: %v", err)
+	}
+
+	expectedChanges := []InventoryChange{
+		{SKU: "sku-a", InventoryItemID: 101, LocationID: 1, Stocked: true, Current: 7, Desired: 10, Adjustment: 3},
+		{SKU: "sku-b", InventoryItemID: 103, LocationID: 1, Desired: 3, Adjustment: 3},
+	}
+	if !reflect.DeepEqual(plan.Changes, expectedChanges) {
+		t.Errorf("InventorySync.Plan returned changes %+v, expected %+v", plan.Changes, expectedChanges)
+	}
+	if plan.Unchanged != 2 {
+		t.Errorf("InventorySync.Plan returned %d unchanged, expected %d", plan.Unchanged, 2)
+	}
+}
+
+func TestInventorySyncSync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerInventorySyncResponders()
+
+	// leave rate limit errors to InventorySync
+	client.retries = 0
+
+	var mu sync.Mutex
+	var adjustCalls int
+	var adjustBody, setBody string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/adjust.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			adjustCalls++
+			if adjustCalls == 1 {
+				resp := httpmock.NewStringResponse(429, `{"errors":"Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service."}`)
+				resp.Header.Set("Retry-After", "2.0")
+				return resp, nil
+			}
+			b, _ := ioutil.ReadAll(req.Body)
+			adjustBody = string(b)
+			return httpmock.NewStringResponse(200, `{"inventory_level":{"inventory_item_id":101,"location_id":1,"available":10}}`), nil
+		})
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/set.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			b, _ := ioutil.ReadAll(req.Body)
+			setBody = string(b)
+			return httpmock.NewStringResponse(422, `{"errors":["Inventory item does not have inventory tracking enabled"]}`), nil
+		})
+
+	var slept []time.Duration
+	inventorySync := NewInventorySync(client)
+	inventorySync.RequestsPerSecond = 0
+	inventorySync.sleep = func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		slept = append(slept, d)
+	}
+
+	report, err := inventorySync.Sync(inventorySyncDesired())
+	if err != nil {
+		t.Fatalf("InventorySync.Sync returned error: %v", err)
+	}
+
+	if adjustCalls != 2 || !reflect.DeepEqual(slept, []time.Duration{2 * time.Second}) {
+		t.Errorf("InventorySync.Sync adjusted %d times and slept %v, expected 2 times and [2s]", adjustCalls, slept)
+	}
+
+	var adjust InventoryLevelAdjustOptions
+	json.Unmarshal([]byte(adjustBody), &adjust)
+	expectedAdjust := InventoryLevelAdjustOptions{InventoryItemID: 101, LocationID: 1, AvailableAdjustment: 3}
+	if adjust != expectedAdjust {
+		t.Errorf("InventorySync.Sync sent adjustment %s, expected %+v", adjustBody, expectedAdjust)
+	}
+
+	var set InventoryLevelSetOptions
+	json.Unmarshal([]byte(setBody), &set)
+	expectedSet := InventoryLevelSetOptions{InventoryItemID: 103, LocationID: 1, Available: 3}
+	if set != expectedSet {
+		t.Errorf("InventorySync.Sync sent set %s, expected %+v", setBody, expectedSet)
+	}
+
+	if len(report.Changes) != 1 || report.Changes[0].SKU != "sku-a" {
+		t.Errorf("InventorySync.Sync reported changes %+v, expected sku-a", report.Changes)
+	}
+	if len(report.Failures) != 1 || report.Failures[0].Change.SKU != "sku-b" {
+		t.Fatalf("InventorySync.Sync reported failures %+v, expected sku-b", report.Failures)
+	}
+	if _, ok := report.Failures[0].Err.(InventoryItemNotTrackedError); !ok {
+		t.Errorf("InventorySync.Sync reported failure %T, expected InventoryItemNotTrackedError", report.Failures[0].Err)
+	}
+	if len(report.Conflicts) != 3 || report.Unchanged != 1 {
+		t.Errorf("InventorySync.Sync reported %d conflicts and %d unchanged, expected 3 and 1", len(report.Conflicts), report.Unchanged)
+	}
+}
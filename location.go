@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 )
 
@@ -17,6 +18,10 @@ type LocationService interface {
 	Get(ID int64, options interface{}) (*Location, error)
 	// Retrieves a count of locations
 	Count(options interface{}) (int, error)
+	// Retrieves the inventory levels of a location
+	ListInventoryLevels(ID int64, options interface{}) ([]InventoryLevel, error)
+	// Retrieves the inventory levels of a location and the pagination to retrieve next/previous results
+	ListInventoryLevelsWithPagination(ID int64, options interface{}) ([]InventoryLevel, *Pagination, error)
 }
 
 type Location struct {
@@ -99,6 +104,35 @@ func (s *LocationServiceOp) Count(options interface{}) (int, error) {
 	return s.client.Count(path, options)
 }
 
+func (s *LocationServiceOp) ListInventoryLevels(ID int64, options interface{}) ([]InventoryLevel, error) {
+	levels, _, err := s.ListInventoryLevelsWithPagination(ID, options)
+	if err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+func (s *LocationServiceOp) ListInventoryLevelsWithPagination(ID int64, options interface{}) ([]InventoryLevel, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/inventory_levels.json", locationsBasePath, ID)
+	resource := new(InventoryLevelsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.InventoryLevels, pagination, nil
+}
+
 // Represents the result from the locations/X.json endpoint
 type LocationResource struct {
 	Location *Location `json:"location"`
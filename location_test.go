@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -102,3 +103,68 @@ func TestLocationServiceOp_Count(t *testing.T) {
 		t.Errorf("Location.Count returned %d, expected %d", cnt, expected)
 	}
 }
+
+func TestLocationServiceOp_ListInventoryLevels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/locations/4688969785/inventory_levels.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("inventory_levels.json")))
+
+	levels, err := client.Location.ListInventoryLevels(4688969785, nil)
+	if err != nil {
+		t.Errorf("Location.ListInventoryLevels returned error: %v", err)
+	}
+
+	updated, _ := time.Parse(time.RFC3339, "2018-02-19T16:20:00-05:00")
+	available := 27
+
+	expected := []InventoryLevel{
+		{
+			InventoryItemID:   808950810,
+			LocationID:        4688969785,
+			Available:         &available,
+			UpdatedAt:         &updated,
+			AdminGraphqlAPIID: "gid://shopify/InventoryLevel/4688969785?inventory_item_id=808950810",
+		},
+		{
+			InventoryItemID:   39072856,
+			LocationID:        4688969785,
+			UpdatedAt:         &updated,
+			AdminGraphqlAPIID: "gid://shopify/InventoryLevel/4688969785?inventory_item_id=39072856",
+		},
+	}
+
+	if !reflect.DeepEqual(levels, expected) {
+		t.Errorf("Location.ListInventoryLevels returned %+v, expected %+v", levels, expected)
+	}
+}
+
+func TestLocationServiceOp_ListInventoryLevelsWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/locations/4688969785/inventory_levels.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"inventory_levels": [{"inventory_item_id":1},{"inventory_item_id":2}]}`)
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	levels, pagination, err := client.Location.ListInventoryLevelsWithPagination(4688969785, ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Location.ListInventoryLevelsWithPagination returned error: %v", err)
+	}
+
+	expectedLevels := []InventoryLevel{{InventoryItemID: 1}, {InventoryItemID: 2}}
+	if !reflect.DeepEqual(levels, expectedLevels) {
+		t.Errorf("Location.ListInventoryLevelsWithPagination returned %+v, expected %+v", levels, expectedLevels)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "foo", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Location.ListInventoryLevelsWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
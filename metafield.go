@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"time"
 )
 
 // MetafieldService is an interface for interfacing with the metafield endpoints
@@ -38,9 +39,17 @@ type MetafieldServiceOp struct {
 
 // Metafield represents a Shopify metafield.
 type Metafield struct {
-	ID    int64       `json:"id,omitempty"`
-	Key   string      `json:"key,omitempty"`
-	Value interface{} `json:"value,omitempty"`
+	ID                int64       `json:"id,omitempty"`
+	Namespace         string      `json:"namespace,omitempty"`
+	Key               string      `json:"key,omitempty"`
+	Value             interface{} `json:"value,omitempty"`
+	Type              string      `json:"type,omitempty"`
+	Description       string      `json:"description,omitempty"`
+	OwnerID           int64       `json:"owner_id,omitempty"`
+	OwnerResource     string      `json:"owner_resource,omitempty"`
+	CreatedAt         *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time  `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID string      `json:"admin_graphql_api_id,omitempty"`
 }
 
 // MetafieldResource represents the result from the metafields/X.json endpoint
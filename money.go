@@ -0,0 +1,88 @@
+package goshopify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Money is an amount of money, optionally in CurrencyCode. It embeds the
+// decimal amount, so decimal methods such as Equal, Add and String can be
+// called on it directly.
+//
+// Money decodes amounts sent as JSON strings or numbers, and is marshaled as a
+// string amount the way Shopify expects. The currency code isn't part of the
+// JSON: it is set by the caller, or from the currency_code of an
+// AmountSetEntry, and is lost when the money is marshaled.
+type Money struct {
+	decimal.Decimal
+	CurrencyCode string
+}
+
+// NewMoney returns the money for amount in currencyCode, which may be empty
+func NewMoney(amount decimal.Decimal, currencyCode string) *Money {
+	return &Money{Decimal: amount, CurrencyCode: currencyCode}
+}
+
+// ParseMoney returns the money for a decimal string amount in currencyCode,
+// which may be empty. It is the migration path from the former string money
+// fields.
+func ParseMoney(amount string, currencyCode string) (*Money, error) {
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q as money: %v", amount, err)
+	}
+	return NewMoney(d, currencyCode), nil
+}
+
+// MoneyFromDecimal returns the money for a decimal amount without a currency,
+// or nil for a nil amount. It is the migration path from the former decimal
+// money fields.
+func MoneyFromDecimal(amount *decimal.Decimal) *Money {
+	if amount == nil {
+		return nil
+	}
+	return NewMoney(*amount, "")
+}
+
+// AmountOrZero returns the amount, or zero for nil money
+func (m *Money) AmountOrZero() decimal.Decimal {
+	if m == nil {
+		return decimal.Zero
+	}
+	return m.Decimal
+}
+
+// DecimalPtr returns the amount like the former *decimal.Decimal money fields,
+// nil for nil money.
+func (m *Money) DecimalPtr() *decimal.Decimal {
+	if m == nil {
+		return nil
+	}
+	amount := m.Decimal
+	return &amount
+}
+
+// AmountString returns the amount like the former string money fields, empty
+// for nil money.
+func (m *Money) AmountString() string {
+	if m == nil {
+		return ""
+	}
+	return m.String()
+}
+
+// MarshalJSON implements json.Marshaler
+func (m Money) MarshalJSON() ([]byte, error) {
+	return m.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		return nil
+	}
+	return m.Decimal.UnmarshalJSON(data)
+}
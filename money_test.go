@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoneyUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		body   string
+		amount string
+	}{
+		{`{"price":"10.50"}`, "10.50"},
+		{`{"price":10.5}`, "10.5"},
+		{`{"price":0}`, "0"},
+	}
+
+	for _, c := range cases {
+		var resource struct {
+			Price *Money `json:"price"`
+		}
+		if err := json.Unmarshal([]byte(c.body), &resource); err != nil {
+			t.Errorf("Money.UnmarshalJSON(%s) returned error: %v", c.body, err)
+			continue
+		}
+		if resource.Price == nil || !resource.Price.Equal(decimal.RequireFromString(c.amount)) {
+			t.Errorf("Money.UnmarshalJSON(%s) returned %+v, expected %s", c.body, resource.Price, c.amount)
+		}
+	}
+
+	var resource struct {
+		Price *Money `json:"price"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":null}`), &resource); err != nil || resource.Price != nil {
+		t.Errorf("Money.UnmarshalJSON(null) returned %+v, %v", resource.Price, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"price":"dog"}`), &resource); err == nil {
+		t.Error("Money.UnmarshalJSON(dog) returned no error")
+	}
+}
+
+func TestMoneyMarshalJSON(t *testing.T) {
+	transaction := Transaction{Amount: NewMoney(decimal.RequireFromString("10.50"), "USD")}
+
+	body, err := json.Marshal(transaction)
+	if err != nil {
+		t.Fatalf("Money.MarshalJSON returned error: %v", err)
+	}
+
+	expected := `{"amount":"10.5"}`
+	if string(body) != expected {
+		t.Errorf("Money.MarshalJSON returned %s, expected %s", body, expected)
+	}
+}
+
+func TestAmountSetEntryUnmarshalJSON(t *testing.T) {
+	var set AmountSet
+	body := `{"shop_money":{"amount":"10.50","currency_code":"USD"},"presentment_money":{"amount":14.2,"currency_code":"CAD"}}`
+	if err := json.Unmarshal([]byte(body), &set); err != nil {
+		t.Fatalf("AmountSetEntry.UnmarshalJSON returned error: %v", err)
+	}
+
+	expected := AmountSet{
+		ShopMoney:        AmountSetEntry{Amount: NewMoney(decimal.RequireFromString("10.50"), "USD"), CurrencyCode: "USD"},
+		PresentmentMoney: AmountSetEntry{Amount: NewMoney(decimal.RequireFromString("14.2"), "CAD"), CurrencyCode: "CAD"},
+	}
+	if !set.ShopMoney.Amount.Equal(expected.ShopMoney.Amount.Decimal) || set.ShopMoney.Amount.CurrencyCode != "USD" || set.ShopMoney.CurrencyCode != "USD" ||
+		!set.PresentmentMoney.Amount.Equal(expected.PresentmentMoney.Amount.Decimal) || set.PresentmentMoney.Amount.CurrencyCode != "CAD" {
+		t.Errorf("AmountSetEntry.UnmarshalJSON returned %+v, expected %+v", set, expected)
+	}
+
+	out, err := json.Marshal(set.ShopMoney)
+	if err != nil {
+		t.Fatalf("AmountSetEntry marshaling returned error: %v", err)
+	}
+	if string(out) != `{"amount":"10.5","currency_code":"USD"}` {
+		t.Errorf("AmountSetEntry marshaled to %s", out)
+	}
+}
+
+func TestMoneyMigrationAccessors(t *testing.T) {
+	var none *Money
+	if none.AmountString() != "" || none.DecimalPtr() != nil || !none.AmountOrZero().IsZero() {
+		t.Errorf("nil Money returned %q, %v, %s", none.AmountString(), none.DecimalPtr(), none.AmountOrZero())
+	}
+
+	money := NewMoney(decimal.RequireFromString("10.50"), "USD")
+	if money.AmountString() != "10.5" {
+		t.Errorf("Money.AmountString returned %s, expected %s", money.AmountString(), "10.5")
+	}
+	if amount := money.DecimalPtr(); amount == nil || !amount.Equal(money.Decimal) {
+		t.Errorf("Money.DecimalPtr returned %v, expected %s", amount, money)
+	}
+	if !money.AmountOrZero().Equal(money.Decimal) {
+		t.Errorf("Money.AmountOrZero returned %s, expected %s", money.AmountOrZero(), money)
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	money, err := ParseMoney("25.00", "USD")
+	if err != nil {
+		t.Fatalf("ParseMoney returned error: %v", err)
+	}
+	if !money.Equal(decimal.New(25, 0)) || money.CurrencyCode != "USD" {
+		t.Errorf("ParseMoney returned %+v", money)
+	}
+
+	if _, err := ParseMoney("dog", ""); err == nil {
+		t.Error("ParseMoney returned no error for an invalid amount")
+	}
+
+	if MoneyFromDecimal(nil) != nil {
+		t.Error("MoneyFromDecimal returned money for a nil decimal")
+	}
+	amount := decimal.New(5, 0)
+	if money := MoneyFromDecimal(&amount); money == nil || !money.Equal(amount) {
+		t.Errorf("MoneyFromDecimal returned %+v", money)
+	}
+}
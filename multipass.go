@@ -0,0 +1,123 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const multipassLoginPath = "account/login/multipass"
+
+// Multipass generates Multipass login tokens for a shop, letting customers
+// that are signed in to an external site log in to the Shopify store.
+// See: https://shopify.dev/api/multipass
+type Multipass struct {
+	encryptionKey []byte
+	signatureKey  []byte
+
+	// used to set created_at, overridden in tests
+	now func() time.Time
+}
+
+// MultipassCustomer represents the customer data encoded in a Multipass token.
+// Email is required, CreatedAt is set to the current time when left empty.
+type MultipassCustomer struct {
+	Email      string             `json:"email"`
+	CreatedAt  *time.Time         `json:"created_at,omitempty"`
+	FirstName  string             `json:"first_name,omitempty"`
+	LastName   string             `json:"last_name,omitempty"`
+	Identifier string             `json:"identifier,omitempty"`
+	TagString  string             `json:"tag_string,omitempty"`
+	RemoteIP   string             `json:"remote_ip,omitempty"`
+	ReturnTo   string             `json:"return_to,omitempty"`
+	Addresses  []*CustomerAddress `json:"addresses,omitempty"`
+}
+
+// NewMultipass returns a Multipass token generator for the given multipass
+// secret, found in the shop's customer account settings.
+func NewMultipass(secret string) *Multipass {
+	// The secret is hashed and split into a 128 bit encryption key and a
+	// 128 bit signature key.
+	key := sha256.Sum256([]byte(secret))
+	m := &Multipass{now: time.Now}
+	if secret != "" {
+		m.encryptionKey = key[:16]
+		m.signatureKey = key[16:]
+	}
+	return m
+}
+
+// Token returns the URL-safe base64 encoded Multipass token for the customer.
+func (m *Multipass) Token(customer MultipassCustomer) (string, error) {
+	if m.encryptionKey == nil {
+		return "", errors.New("multipass secret is empty")
+	}
+	if customer.Email == "" {
+		return "", errors.New("multipass customer email is empty")
+	}
+
+	if customer.CreatedAt == nil {
+		now := m.now().UTC()
+		customer.CreatedAt = &now
+	}
+
+	js, err := json.Marshal(customer)
+	if err != nil {
+		return "", err
+	}
+
+	cipherText, err := m.encrypt(js)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(append(cipherText, m.sign(cipherText)...)), nil
+}
+
+// URL returns the Multipass login URL for the customer on the given shop, e.g.
+// https://theshop.myshopify.com/account/login/multipass/{token}
+func (m *Multipass) URL(shopName string, customer MultipassCustomer) (string, error) {
+	token, err := m.Token(customer)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s", ShopBaseUrl(shopName), multipassLoginPath, token), nil
+}
+
+// encrypt encrypts plainText with AES-128-CBC using a random IV, which is
+// prepended to the cipher text.
+func (m *Multipass) encrypt(plainText []byte) ([]byte, error) {
+	block, err := aes.NewCipher(m.encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// PKCS#7 padding
+	padding := aes.BlockSize - len(plainText)%aes.BlockSize
+	plainText = append(plainText, bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	cipherText := make([]byte, aes.BlockSize+len(plainText))
+	iv := cipherText[:aes.BlockSize]
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(cipherText[aes.BlockSize:], plainText)
+	return cipherText, nil
+}
+
+// sign returns the HMAC-SHA256 signature of the cipher text.
+func (m *Multipass) sign(cipherText []byte) []byte {
+	mac := hmac.New(sha256.New, m.signatureKey)
+	mac.Write(cipherText)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,126 @@
+package goshopify
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// decodeMultipassToken verifies and decrypts a token the way Shopify does.
+func decodeMultipassToken(t *testing.T, secret, token string) map[string]interface{} {
+	key := sha256.Sum256([]byte(secret))
+
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatalf("token is not URL-safe base64: %v", err)
+	}
+
+	cipherText, signature := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+	mac := hmac.New(sha256.New, key[16:])
+	mac.Write(cipherText)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		t.Fatalf("token signature does not match")
+	}
+
+	block, _ := aes.NewCipher(key[:16])
+	plainText := make([]byte, len(cipherText)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, cipherText[:aes.BlockSize]).CryptBlocks(plainText, cipherText[aes.BlockSize:])
+	plainText = plainText[:len(plainText)-int(plainText[len(plainText)-1])]
+
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(plainText, &data); err != nil {
+		t.Fatalf("token payload is not JSON: %v", err)
+	}
+	return data
+}
+
+func TestMultipassToken(t *testing.T) {
+	multipass := NewMultipass("multipass secret")
+	multipass.now = func() time.Time {
+		return time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	token, err := multipass.Token(MultipassCustomer{
+		Email:      "bob@example.com",
+		FirstName:  "Bob",
+		Identifier: "bob123",
+		ReturnTo:   "https://fooshop.myshopify.com/cart",
+	})
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	data := decodeMultipassToken(t, "multipass secret", token)
+	expected := map[string]interface{}{
+		"email":      "bob@example.com",
+		"created_at": "2020-01-02T03:04:05Z",
+		"first_name": "Bob",
+		"identifier": "bob123",
+		"return_to":  "https://fooshop.myshopify.com/cart",
+	}
+	for k, v := range expected {
+		if data[k] != v {
+			t.Errorf("Multipass.Token %s = %v, expected %v", k, data[k], v)
+		}
+	}
+	if len(data) != len(expected) {
+		t.Errorf("Multipass.Token encoded %v, expected %v", data, expected)
+	}
+}
+
+func TestMultipassTokenKeepsCreatedAt(t *testing.T) {
+	createdAt := time.Date(2019, time.March, 4, 5, 6, 7, 0, time.UTC)
+	token, err := NewMultipass("multipass secret").Token(MultipassCustomer{
+		Email:     "bob@example.com",
+		CreatedAt: &createdAt,
+	})
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	data := decodeMultipassToken(t, "multipass secret", token)
+	if data["created_at"] != "2019-03-04T05:06:07Z" {
+		t.Errorf("Multipass.Token created_at = %v, expected %v", data["created_at"], "2019-03-04T05:06:07Z")
+	}
+}
+
+func TestMultipassURL(t *testing.T) {
+	url, err := NewMultipass("multipass secret").URL("fooshop", MultipassCustomer{Email: "bob@example.com"})
+	if err != nil {
+		t.Fatalf("Multipass.URL returned error: %v", err)
+	}
+
+	prefix := "https://fooshop.myshopify.com/account/login/multipass/"
+	if !strings.HasPrefix(url, prefix) {
+		t.Fatalf("Multipass.URL returned %s, expected prefix %s", url, prefix)
+	}
+
+	data := decodeMultipassToken(t, "multipass secret", strings.TrimPrefix(url, prefix))
+	if data["email"] != "bob@example.com" {
+		t.Errorf("Multipass.URL email = %v, expected %v", data["email"], "bob@example.com")
+	}
+}
+
+func TestMultipassTokenErrors(t *testing.T) {
+	cases := []struct {
+		secret        string
+		customer      MultipassCustomer
+		expectedError string
+	}{
+		{"", MultipassCustomer{Email: "bob@example.com"}, "multipass secret is empty"},
+		{"multipass secret", MultipassCustomer{}, "multipass customer email is empty"},
+	}
+
+	for _, c := range cases {
+		_, err := NewMultipass(c.secret).Token(c.customer)
+		if err == nil || err.Error() != c.expectedError {
+			t.Errorf("Multipass.Token expected error %s, got %v", c.expectedError, err)
+		}
+	}
+}
@@ -89,6 +89,14 @@ func (app App) VerifyAuthorizationURL(u *url.URL) (bool, error) {
 	return app.VerifyMessage(message, messageMAC), err
 }
 
+// Signs a webhook body the same way Shopify does, returning the value of the
+// X-Shopify-Hmac-Sha256 header.
+func (app App) SignWebhookBody(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
 // Verifies a webhook http request, sent by Shopify.
 // The body of the request is still readable after invoking the method.
 func (app App) VerifyWebhookRequest(httpRequest *http.Request) bool {
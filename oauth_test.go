@@ -106,6 +106,18 @@ func TestAppVerifyAuthorizationURL(t *testing.T) {
 	}
 }
 
+func TestSignWebhookBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expected := "hMTq0K2x7oyOjoBwGYeTj5oxfnaVYXzbanUG9aajpKI="
+	// the body of the verification tests is JSON encoded
+	actual := app.SignWebhookBody([]byte(`"my secret message"`))
+	if actual != expected {
+		t.Errorf("App.SignWebhookBody(): expected %s, actual %s", expected, actual)
+	}
+}
+
 func TestVerifyWebhookRequest(t *testing.T) {
 	setup()
 	defer teardown()
@@ -72,36 +72,101 @@ type OrderListOptions struct {
 
 // OrderCancelOptions ...
 type OrderCancelOptions struct {
-	Amount   *decimal.Decimal `json:"amount,omitempty"`
-	Currency string           `json:"currency,omitempty"`
-	Restock  bool             `json:"restock,omitempty"`
-	Reason   string           `json:"reason,omitempty"`
-	Email    bool             `json:"email,omitempty"`
-	Refund   *Refund          `json:"refund,omitempty"`
+	Amount   *Money  `json:"amount,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+	Restock  bool    `json:"restock,omitempty"`
+	Reason   string  `json:"reason,omitempty"`
+	Email    bool    `json:"email,omitempty"`
+	Refund   *Refund `json:"refund,omitempty"`
 }
 
 // Order represents a Shopify order
 type Order struct {
-	ID                   int64                 `json:"id,omitempty"`
-	Name                 string                `json:"name,omitempty"`
-	Email                string                `json:"email,omitempty"`
-	CreatedAt            *time.Time            `json:"created_at,omitempty"`
-	UpdatedAt            *time.Time            `json:"updated_at,omitempty"`
-	CancelledAt          *time.Time            `json:"cancelled_at,omitempty"`
-	ProcessedAt          *time.Time            `json:"processed_at,omitempty"`
-	SubtotalPrice        *decimal.Decimal      `json:"subtotal_price,omitempty"`
-	TaxesIncluded        bool                  `json:"taxes_included,omitempty"`
-	FinancialStatus      string                `json:"financial_status,omitempty"`
-	ConfirmationNumber   string                `json:"confirmation_number"`
-	TotalPrice           string                `json:"total_price"`
-	TotalDiscounts       string                `json:"total_discounts"`
-	Currency             string                `json:"currency"`
-	LineItems            []LineItem            `json:"line_items,omitempty"`
-	SourceName           string                `json:"source_name,omitempty"`
-	Tags                 string                `json:"tags,omitempty"`
-	CheckoutToken        string                `json:"checkout_token,omitempty"`
-	Metafields           []Metafield           `json:"metafields,omitempty"`
-	DiscountApplications []DiscountApplication `json:"discount_applications"`
+	ID                       int64                 `json:"id,omitempty"`
+	Name                     string                `json:"name,omitempty"`
+	Email                    string                `json:"email,omitempty"`
+	CreatedAt                *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt                *time.Time            `json:"updated_at,omitempty"`
+	CancelledAt              *time.Time            `json:"cancelled_at,omitempty"`
+	ClosedAt                 *time.Time            `json:"closed_at,omitempty"`
+	ProcessedAt              *time.Time            `json:"processed_at,omitempty"`
+	Customer                 *Customer             `json:"customer,omitempty"`
+	BillingAddress           *Address              `json:"billing_address,omitempty"`
+	ShippingAddress          *Address              `json:"shipping_address,omitempty"`
+	Currency                 string                `json:"currency"`
+	PresentmentCurrency      string                `json:"presentment_currency,omitempty"`
+	TotalPrice               *Money                `json:"total_price,omitempty"`
+	TotalPriceSet            *AmountSet            `json:"total_price_set,omitempty"`
+	TotalPriceUSD            *Money                `json:"total_price_usd,omitempty"`
+	CurrentTotalPrice        *Money                `json:"current_total_price,omitempty"`
+	CurrentTotalPriceSet     *AmountSet            `json:"current_total_price_set,omitempty"`
+	CurrentSubtotalPrice     *Money                `json:"current_subtotal_price,omitempty"`
+	CurrentSubtotalPriceSet  *AmountSet            `json:"current_subtotal_price_set,omitempty"`
+	CurrentTotalDiscounts    *Money                `json:"current_total_discounts,omitempty"`
+	CurrentTotalDiscountsSet *AmountSet            `json:"current_total_discounts_set,omitempty"`
+	CurrentTotalTax          *Money                `json:"current_total_tax,omitempty"`
+	CurrentTotalTaxSet       *AmountSet            `json:"current_total_tax_set,omitempty"`
+	CurrentTotalDutiesSet    *AmountSet            `json:"current_total_duties_set,omitempty"`
+	OriginalTotalDutiesSet   *AmountSet            `json:"original_total_duties_set,omitempty"`
+	SubtotalPrice            *Money                `json:"subtotal_price,omitempty"`
+	SubtotalPriceSet         *AmountSet            `json:"subtotal_price_set,omitempty"`
+	TotalDiscounts           *Money                `json:"total_discounts,omitempty"`
+	TotalDiscountsSet        *AmountSet            `json:"total_discounts_set,omitempty"`
+	TotalLineItemsPrice      *Money                `json:"total_line_items_price,omitempty"`
+	TotalLineItemsPriceSet   *AmountSet            `json:"total_line_items_price_set,omitempty"`
+	TotalShippingPriceSet    *AmountSet            `json:"total_shipping_price_set,omitempty"`
+	TaxesIncluded            bool                  `json:"taxes_included,omitempty"`
+	TotalTax                 *Money                `json:"total_tax,omitempty"`
+	TotalTaxSet              *AmountSet            `json:"total_tax_set,omitempty"`
+	TaxLines                 []TaxLine             `json:"tax_lines,omitempty"`
+	TotalWeight              int                   `json:"total_weight,omitempty"`
+	FinancialStatus          string                `json:"financial_status,omitempty"`
+	FulfillmentStatus        string                `json:"fulfillment_status,omitempty"`
+	Fulfillments             []Fulfillment         `json:"fulfillments,omitempty"`
+	Refunds                  []Refund              `json:"refunds,omitempty"`
+	Transactions             []Transaction         `json:"transactions,omitempty"`
+	Token                    string                `json:"token,omitempty"`
+	CartToken                string                `json:"cart_token,omitempty"`
+	CheckoutToken            string                `json:"checkout_token,omitempty"`
+	CheckoutID               int64                 `json:"checkout_id,omitempty"`
+	ConfirmationNumber       string                `json:"confirmation_number"`
+	Number                   int                   `json:"number,omitempty"`
+	OrderNumber              int                   `json:"order_number,omitempty"`
+	OrderStatusURL           string                `json:"order_status_url,omitempty"`
+	Note                     string                `json:"note,omitempty"`
+	NoteAttributes           []NoteAttribute       `json:"note_attributes,omitempty"`
+	Test                     bool                  `json:"test,omitempty"`
+	Confirmed                bool                  `json:"confirmed,omitempty"`
+	BrowserIp                string                `json:"browser_ip,omitempty"`
+	BuyerAcceptsMarketing    bool                  `json:"buyer_accepts_marketing,omitempty"`
+	CancelReason             string                `json:"cancel_reason,omitempty"`
+	DiscountCodes            []DiscountCode        `json:"discount_codes,omitempty"`
+	DiscountApplications     []DiscountApplication `json:"discount_applications"`
+	LineItems                []LineItem            `json:"line_items,omitempty"`
+	ShippingLines            []ShippingLines       `json:"shipping_lines,omitempty"`
+	AppID                    int64                 `json:"app_id,omitempty"`
+	UserID                   int64                 `json:"user_id,omitempty"`
+	LocationID               int64                 `json:"location_id,omitempty"`
+	DeviceID                 int64                 `json:"device_id,omitempty"`
+	CustomerLocale           string                `json:"customer_locale,omitempty"`
+	ClientDetails            *ClientDetails        `json:"client_details,omitempty"`
+	LandingSite              string                `json:"landing_site,omitempty"`
+	LandingSiteRef           string                `json:"landing_site_ref,omitempty"`
+	ReferringSite            string                `json:"referring_site,omitempty"`
+	SourceName               string                `json:"source_name,omitempty"`
+	SourceIdentifier         string                `json:"source_identifier,omitempty"`
+	SourceURL                string                `json:"source_url,omitempty"`
+	Reference                string                `json:"reference,omitempty"`
+	Gateway                  string                `json:"gateway,omitempty"`
+	PaymentGatewayNames      []string              `json:"payment_gateway_names,omitempty"`
+	ProcessingMethod         string                `json:"processing_method,omitempty"`
+	Phone                    string                `json:"phone,omitempty"`
+	ContactEmail             string                `json:"contact_email,omitempty"`
+	Tags                     string                `json:"tags,omitempty"`
+	Metafields               []Metafield           `json:"metafields,omitempty"`
+	AdminGraphqlAPIID        string                `json:"admin_graphql_api_id,omitempty"`
+	EstimatedTaxes           bool                  `json:"estimated_taxes,omitempty"`
+	TaxExempt                bool                  `json:"tax_exempt,omitempty"`
 }
 
 // DiscountApplication ...
@@ -136,45 +201,48 @@ type Address struct {
 }
 
 type DiscountCode struct {
-	Amount *decimal.Decimal `json:"amount,omitempty"`
-	Code   string           `json:"code,omitempty"`
-	Type   string           `json:"type,omitempty"`
+	Amount *Money `json:"amount,omitempty"`
+	Code   string `json:"code,omitempty"`
+	Type   string `json:"type,omitempty"`
 }
 
 type LineItem struct {
-	ID                         int64            `json:"id,omitempty"`
-	ProductID                  int64            `json:"product_id,omitempty"`
-	VariantID                  int64            `json:"variant_id,omitempty"`
-	Quantity                   int              `json:"quantity,omitempty"`
-	Price                      *decimal.Decimal `json:"price,omitempty"`
-	TotalDiscount              *decimal.Decimal `json:"total_discount,omitempty"`
-	Title                      string           `json:"title,omitempty"`
-	VariantTitle               string           `json:"variant_title,omitempty"`
-	Name                       string           `json:"name,omitempty"`
-	SKU                        string           `json:"sku,omitempty"`
-	Vendor                     string           `json:"vendor,omitempty"`
-	GiftCard                   bool             `json:"gift_card,omitempty"`
-	Taxable                    bool             `json:"taxable,omitempty"`
-	FulfillmentService         string           `json:"fulfillment_service,omitempty"`
-	RequiresShipping           bool             `json:"requires_shipping,omitempty"`
-	VariantInventoryManagement string           `json:"variant_inventory_management,omitempty"`
-	PreTaxPrice                *decimal.Decimal `json:"pre_tax_price,omitempty"`
-	Properties                 []NoteAttribute  `json:"properties,omitempty"`
-	ProductExists              bool             `json:"product_exists,omitempty"`
-	FulfillableQuantity        int              `json:"fulfillable_quantity,omitempty"`
-	// Grams                      int              `json:"grams,omitempty"`
-	FulfillmentStatus string    `json:"fulfillment_status,omitempty"`
-	TaxLines          []TaxLine `json:"tax_lines,omitempty"`
-	// OriginLocation             *Address         `json:"origin_location,omitempty"`
-	// DestinationLocation        *Address         `json:"destination_location,omitempty"`
-	AppliedDiscount     *AppliedDiscount      `json:"applied_discount,omitempty"`
-	DiscountAllocations []DiscountAllocations `json:"discount_allocations,omitempty"`
+	ID                         int64                 `json:"id,omitempty"`
+	ProductID                  int64                 `json:"product_id,omitempty"`
+	VariantID                  int64                 `json:"variant_id,omitempty"`
+	Quantity                   int                   `json:"quantity,omitempty"`
+	Price                      *Money                `json:"price,omitempty"`
+	PriceSet                   *AmountSet            `json:"price_set,omitempty"`
+	TotalDiscount              *Money                `json:"total_discount,omitempty"`
+	TotalDiscountSet           *AmountSet            `json:"total_discount_set,omitempty"`
+	Title                      string                `json:"title,omitempty"`
+	VariantTitle               string                `json:"variant_title,omitempty"`
+	Name                       string                `json:"name,omitempty"`
+	SKU                        string                `json:"sku,omitempty"`
+	Vendor                     string                `json:"vendor,omitempty"`
+	GiftCard                   bool                  `json:"gift_card,omitempty"`
+	Taxable                    bool                  `json:"taxable,omitempty"`
+	FulfillmentService         string                `json:"fulfillment_service,omitempty"`
+	RequiresShipping           bool                  `json:"requires_shipping,omitempty"`
+	VariantInventoryManagement string                `json:"variant_inventory_management,omitempty"`
+	PreTaxPrice                *Money                `json:"pre_tax_price,omitempty"`
+	PreTaxPriceSet             *AmountSet            `json:"pre_tax_price_set,omitempty"`
+	Properties                 []NoteAttribute       `json:"properties,omitempty"`
+	ProductExists              bool                  `json:"product_exists,omitempty"`
+	FulfillableQuantity        int                   `json:"fulfillable_quantity,omitempty"`
+	Grams                      int                   `json:"grams,omitempty"`
+	FulfillmentStatus          string                `json:"fulfillment_status,omitempty"`
+	TaxLines                   []TaxLine             `json:"tax_lines,omitempty"`
+	OriginLocation             *Address              `json:"origin_location,omitempty"`
+	DestinationLocation        *Address              `json:"destination_location,omitempty"`
+	AppliedDiscount            *AppliedDiscount      `json:"applied_discount,omitempty"`
+	DiscountAllocations        []DiscountAllocations `json:"discount_allocations,omitempty"`
 }
 
 type DiscountAllocations struct {
-	Amount                   *decimal.Decimal `json:"amount,omitempty"`
-	DiscountApplicationIndex int              `json:"discount_application_index,omitempty"`
-	AmountSet                AmountSet        `json:"amount_set,omitempty"`
+	Amount                   *Money    `json:"amount,omitempty"`
+	DiscountApplicationIndex int       `json:"discount_application_index,omitempty"`
+	AmountSet                AmountSet `json:"amount_set,omitempty"`
 }
 
 type AmountSet struct {
@@ -183,8 +251,30 @@ type AmountSet struct {
 }
 
 type AmountSetEntry struct {
-	Amount       *decimal.Decimal `json:"amount,omitempty"`
-	CurrencyCode string           `json:"currency_code,omitempty"`
+	Amount       *Money `json:"amount,omitempty"`
+	CurrencyCode string `json:"currency_code,omitempty"`
+}
+
+// UnmarshalJSON sets the currency code of the amount from the entry.
+// Transaction money sets name the currency code "currency".
+func (e *AmountSetEntry) UnmarshalJSON(data []byte) error {
+	entry := struct {
+		Amount       *Money `json:"amount"`
+		CurrencyCode string `json:"currency_code"`
+		Currency     string `json:"currency"`
+	}{}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+
+	e.Amount, e.CurrencyCode = entry.Amount, entry.CurrencyCode
+	if e.CurrencyCode == "" {
+		e.CurrencyCode = entry.Currency
+	}
+	if e.Amount != nil {
+		e.Amount.CurrencyCode = e.CurrencyCode
+	}
+	return nil
 }
 
 // UnmarshalJSON custom unmarsaller for LineItem required to mitigate some older orders having LineItem.Properies
@@ -254,16 +344,19 @@ type PaymentDetails struct {
 }
 
 type ShippingLines struct {
-	ID                            int64            `json:"id,omitempty"`
-	Title                         string           `json:"title,omitempty"`
-	Price                         *decimal.Decimal `json:"price,omitempty"`
-	Code                          string           `json:"code,omitempty"`
-	Source                        string           `json:"source,omitempty"`
-	Phone                         string           `json:"phone,omitempty"`
-	RequestedFulfillmentServiceID string           `json:"requested_fulfillment_service_id,omitempty"`
-	DeliveryCategory              string           `json:"delivery_category,omitempty"`
-	CarrierIdentifier             string           `json:"carrier_identifier,omitempty"`
-	TaxLines                      []TaxLine        `json:"tax_lines,omitempty"`
+	ID                            int64      `json:"id,omitempty"`
+	Title                         string     `json:"title,omitempty"`
+	Price                         *Money     `json:"price,omitempty"`
+	PriceSet                      *AmountSet `json:"price_set,omitempty"`
+	DiscountedPrice               *Money     `json:"discounted_price,omitempty"`
+	DiscountedPriceSet            *AmountSet `json:"discounted_price_set,omitempty"`
+	Code                          string     `json:"code,omitempty"`
+	Source                        string     `json:"source,omitempty"`
+	Phone                         string     `json:"phone,omitempty"`
+	RequestedFulfillmentServiceID string     `json:"requested_fulfillment_service_id,omitempty"`
+	DeliveryCategory              string     `json:"delivery_category,omitempty"`
+	CarrierIdentifier             string     `json:"carrier_identifier,omitempty"`
+	TaxLines                      []TaxLine  `json:"tax_lines,omitempty"`
 }
 
 // UnmarshalJSON custom unmarshaller for ShippingLines implemented to handle requested_fulfillment_service_id being
@@ -291,31 +384,41 @@ func (sl *ShippingLines) UnmarshalJSON(data []byte) error {
 }
 
 type TaxLine struct {
-	Title string           `json:"title,omitempty"`
-	Price *decimal.Decimal `json:"price,omitempty"`
-	Rate  *decimal.Decimal `json:"rate,omitempty"`
+	Title    string           `json:"title,omitempty"`
+	Price    *Money           `json:"price,omitempty"`
+	PriceSet *AmountSet       `json:"price_set,omitempty"`
+	Rate     *decimal.Decimal `json:"rate,omitempty"`
 }
 
 type Transaction struct {
-	ID             int64            `json:"id,omitempty"`
-	OrderID        int64            `json:"order_id,omitempty"`
-	Amount         *decimal.Decimal `json:"amount,omitempty"`
-	Kind           string           `json:"kind,omitempty"`
-	Gateway        string           `json:"gateway,omitempty"`
-	Status         string           `json:"status,omitempty"`
-	Message        string           `json:"message,omitempty"`
-	CreatedAt      *time.Time       `json:"created_at,omitempty"`
-	Test           bool             `json:"test,omitempty"`
-	Authorization  string           `json:"authorization,omitempty"`
-	Currency       string           `json:"currency,omitempty"`
-	LocationID     *int64           `json:"location_id,omitempty"`
-	UserID         *int64           `json:"user_id,omitempty"`
-	ParentID       *int64           `json:"parent_id,omitempty"`
-	DeviceID       *int64           `json:"device_id,omitempty"`
-	ErrorCode      string           `json:"error_code,omitempty"`
-	SourceName     string           `json:"source_name,omitempty"`
-	Source         string           `json:"source,omitempty"`
-	PaymentDetails *PaymentDetails  `json:"payment_details,omitempty"`
+	ID                int64           `json:"id,omitempty"`
+	OrderID           int64           `json:"order_id,omitempty"`
+	Amount            *Money          `json:"amount,omitempty"`
+	Kind              string          `json:"kind,omitempty"`
+	Gateway           string          `json:"gateway,omitempty"`
+	Status            string          `json:"status,omitempty"`
+	Message           string          `json:"message,omitempty"`
+	CreatedAt         *time.Time      `json:"created_at,omitempty"`
+	Test              bool            `json:"test,omitempty"`
+	Authorization     string          `json:"authorization,omitempty"`
+	Currency          string          `json:"currency,omitempty"`
+	LocationID        *int64          `json:"location_id,omitempty"`
+	UserID            *int64          `json:"user_id,omitempty"`
+	ParentID          *int64          `json:"parent_id,omitempty"`
+	DeviceID          *int64          `json:"device_id,omitempty"`
+	ErrorCode         string          `json:"error_code,omitempty"`
+	SourceName        string          `json:"source_name,omitempty"`
+	Source            string          `json:"source,omitempty"`
+	PaymentDetails    *PaymentDetails `json:"payment_details,omitempty"`
+	TotalUnsettledSet *AmountSet      `json:"total_unsettled_set,omitempty"`
+
+	// Receipt is the gateway's response, its fields depend on the gateway
+	Receipt                    map[string]interface{}      `json:"receipt,omitempty"`
+	CurrencyExchangeAdjustment *CurrencyExchangeAdjustment `json:"currency_exchange_adjustment,omitempty"`
+	PaymentsRefundAttributes   *PaymentsRefundAttributes   `json:"payments_refund_attributes,omitempty"`
+
+	// Set on the suggested transactions of a calculated refund
+	MaximumRefundable *Money `json:"maximum_refundable,omitempty"`
 }
 
 type ClientDetails struct {
@@ -327,26 +430,6 @@ type ClientDetails struct {
 	UserAgent      string `json:"user_agent,omitempty"`
 }
 
-type Refund struct {
-	Id              int64            `json:"id,omitempty"`
-	OrderId         int64            `json:"order_id,omitempty"`
-	CreatedAt       *time.Time       `json:"created_at,omitempty"`
-	Note            string           `json:"note,omitempty"`
-	Restock         bool             `json:"restock,omitempty"`
-	UserId          int64            `json:"user_id,omitempty"`
-	RefundLineItems []RefundLineItem `json:"refund_line_items,omitempty"`
-	Transactions    []Transaction    `json:"transactions,omitempty"`
-}
-
-type RefundLineItem struct {
-	Id         int64            `json:"id,omitempty"`
-	Quantity   int              `json:"quantity,omitempty"`
-	LineItemId int64            `json:"line_item_id,omitempty"`
-	LineItem   *LineItem        `json:"line_item,omitempty"`
-	Subtotal   *decimal.Decimal `json:"subtotal,omitempty"`
-	TotalTax   *decimal.Decimal `json:"total_tax,omitempty"`
-}
-
 // List orders
 func (s *OrderServiceOp) List(options interface{}) ([]Order, error) {
 	orders, _, err := s.ListWithPagination(options)
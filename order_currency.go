@@ -0,0 +1,128 @@
+package goshopify
+
+import "fmt"
+
+// CurrencyNotEnabledError is returned when amounts are requested in a
+// currency that isn't enabled on the shop
+type CurrencyNotEnabledError struct {
+	Currency string
+}
+
+func (e CurrencyNotEnabledError) Error() string {
+	return fmt.Sprintf("currency %s is not enabled", e.Currency)
+}
+
+// CurrencyMismatchError is returned when the money of a set is in another
+// currency than the order's shop or presentment currency
+type CurrencyMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (e CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("money in %s does not match order currency %s", e.Actual, e.Expected)
+}
+
+// OrderTotals are the totals of an order in a single currency
+type OrderTotals struct {
+	Currency  string
+	Subtotal  *Money
+	Discounts *Money
+	Shipping  *Money
+	Tax       *Money
+	Total     *Money
+}
+
+// In returns the money of the set in currencyCode, either the shop or the
+// presentment money, and whether the set has money in that currency.
+func (s AmountSet) In(currencyCode string) (*Money, bool) {
+	for _, entry := range []AmountSetEntry{s.ShopMoney, s.PresentmentMoney} {
+		if entry.CurrencyCode == currencyCode && entry.Amount != nil {
+			return NewMoney(entry.Amount.Decimal, entry.CurrencyCode), true
+		}
+	}
+	return nil, false
+}
+
+// ShopCurrency returns the currency of the shop the order was placed in
+func (o *Order) ShopCurrency() string {
+	return o.Currency
+}
+
+// CustomerCurrency returns the currency the customer was presented, which is
+// the shop currency for orders without a presentment currency.
+func (o *Order) CustomerCurrency() string {
+	if o.PresentmentCurrency != "" {
+		return o.PresentmentCurrency
+	}
+	return o.Currency
+}
+
+// AmountIn returns the money of a set of the order in currencyCode, after
+// checking the set against the order's shop and presentment currencies.
+// currencyCode must be one of them. A nil set returns nil money.
+func (o *Order) AmountIn(set *AmountSet, currencyCode string) (*Money, error) {
+	if set == nil {
+		return nil, nil
+	}
+
+	if code := set.ShopMoney.CurrencyCode; code != "" && o.Currency != "" && code != o.Currency {
+		return nil, CurrencyMismatchError{Expected: o.Currency, Actual: code}
+	}
+	if code := set.PresentmentMoney.CurrencyCode; code != "" && code != o.CustomerCurrency() {
+		return nil, CurrencyMismatchError{Expected: o.CustomerCurrency(), Actual: code}
+	}
+
+	if currencyCode != o.ShopCurrency() && currencyCode != o.CustomerCurrency() {
+		return nil, fmt.Errorf("order %d has no amounts in %s, only in %s and %s", o.ID, currencyCode, o.ShopCurrency(), o.CustomerCurrency())
+	}
+
+	money, ok := set.In(currencyCode)
+	if !ok {
+		return nil, fmt.Errorf("order %d has no amount in %s", o.ID, currencyCode)
+	}
+	return money, nil
+}
+
+// TotalsIn returns the totals of the order in currencyCode, either its shop
+// or presentment currency. Totals without a money set are nil.
+func (o *Order) TotalsIn(currencyCode string) (*OrderTotals, error) {
+	totals := &OrderTotals{Currency: currencyCode}
+
+	for _, amount := range []struct {
+		set   *AmountSet
+		money **Money
+	}{
+		{o.SubtotalPriceSet, &totals.Subtotal},
+		{o.TotalDiscountsSet, &totals.Discounts},
+		{o.TotalShippingPriceSet, &totals.Shipping},
+		{o.TotalTaxSet, &totals.Tax},
+		{o.TotalPriceSet, &totals.Total},
+	} {
+		money, err := o.AmountIn(amount.set, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		*amount.money = money
+	}
+
+	return totals, nil
+}
+
+// OrderTotalsIn returns the totals of the order in currencyCode, like
+// Order.TotalsIn, after checking with currencies that currencyCode is enabled
+// on the shop. Currencies other than the order's shop currency that aren't
+// enabled return a CurrencyNotEnabledError.
+func OrderTotalsIn(currencies CurrencyService, order *Order, currencyCode string) (*OrderTotals, error) {
+	if currencyCode != order.ShopCurrency() {
+		enabled, err := currencies.IsEnabled(currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			return nil, CurrencyNotEnabledError{Currency: currencyCode}
+		}
+	}
+
+	return order.TotalsIn(currencyCode)
+}
@@ -0,0 +1,110 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func loadPresentmentOrder(t *testing.T) *Order {
+	resource := new(OrderResource)
+	if err := json.Unmarshal(loadFixture("order_presentment.json"), resource); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	return resource.Order
+}
+
+func moneyTests(t *testing.T, name string, money *Money, amount string, currencyCode string) {
+	if money == nil || !money.Equal(decimal.RequireFromString(amount)) || money.CurrencyCode != currencyCode {
+		t.Errorf("%s returned %+v, expected %s %s", name, money, amount, currencyCode)
+	}
+}
+
+func TestOrderMoneySets(t *testing.T) {
+	order := loadPresentmentOrder(t)
+
+	lineItem := order.LineItems[0]
+	price, _ := lineItem.PriceSet.In("CAD")
+	moneyTests(t, "LineItem.PriceSet.In", price, "135.00", "CAD")
+
+	tax, _ := lineItem.TaxLines[0].PriceSet.In("USD")
+	moneyTests(t, "TaxLine.PriceSet.In", tax, "13.00", "USD")
+
+	shipping, _ := order.ShippingLines[0].DiscountedPriceSet.In("CAD")
+	moneyTests(t, "ShippingLines.DiscountedPriceSet.In", shipping, "6.75", "CAD")
+
+	if _, ok := lineItem.PriceSet.In("EUR"); ok {
+		t.Error("AmountSet.In returned money in EUR")
+	}
+}
+
+func TestOrderTotalsIn(t *testing.T) {
+	order := loadPresentmentOrder(t)
+
+	if order.ShopCurrency() != "USD" || order.CustomerCurrency() != "CAD" {
+		t.Errorf("Order currencies returned %s and %s", order.ShopCurrency(), order.CustomerCurrency())
+	}
+
+	totals, err := order.TotalsIn("CAD")
+	if err != nil {
+		t.Fatalf("Order.TotalsIn returned error: %v", err)
+	}
+	moneyTests(t, "OrderTotals.Subtotal", totals.Subtotal, "135.00", "CAD")
+	moneyTests(t, "OrderTotals.Discounts", totals.Discounts, "13.50", "CAD")
+	moneyTests(t, "OrderTotals.Shipping", totals.Shipping, "6.75", "CAD")
+	moneyTests(t, "OrderTotals.Tax", totals.Tax, "17.55", "CAD")
+	moneyTests(t, "OrderTotals.Total", totals.Total, "159.30", "CAD")
+
+	totals, err = order.TotalsIn("USD")
+	if err != nil {
+		t.Fatalf("Order.TotalsIn returned error: %v", err)
+	}
+	moneyTests(t, "OrderTotals.Total", totals.Total, "118.00", "USD")
+
+	if _, err := order.TotalsIn("EUR"); err == nil {
+		t.Error("Order.TotalsIn returned no error for a currency not on the order")
+	}
+}
+
+func TestOrderAmountInMismatch(t *testing.T) {
+	order := loadPresentmentOrder(t)
+	order.Currency = "EUR"
+
+	_, err := order.AmountIn(order.TotalPriceSet, "CAD")
+	if _, ok := err.(CurrencyMismatchError); !ok {
+		t.Errorf("Order.AmountIn returned %v, expected a CurrencyMismatchError", err)
+	}
+
+	money, err := order.AmountIn(nil, "CAD")
+	if money != nil || err != nil {
+		t.Errorf("Order.AmountIn returned %v, %v for a nil set", money, err)
+	}
+}
+
+func TestOrderTotalsInEnabledCurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/currencies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("currencies.json")))
+
+	order := loadPresentmentOrder(t)
+
+	totals, err := OrderTotalsIn(client.Currency, order, "CAD")
+	if err != nil {
+		t.Fatalf("OrderTotalsIn returned error: %v", err)
+	}
+	moneyTests(t, "OrderTotals.Total", totals.Total, "159.30", "CAD")
+
+	if _, err := OrderTotalsIn(client.Currency, order, "USD"); err != nil {
+		t.Errorf("OrderTotalsIn returned error for the shop currency: %v", err)
+	}
+
+	_, err = OrderTotalsIn(client.Currency, order, "JPY")
+	if e, ok := err.(CurrencyNotEnabledError); !ok || e.Currency != "JPY" {
+		t.Errorf("OrderTotalsIn returned %v, expected a CurrencyNotEnabledError", err)
+	}
+}
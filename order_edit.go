@@ -0,0 +1,339 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OrderEditService is an interface for editing orders after checkout with
+// the order editing mutations of the Admin GraphQL API. An edit is started
+// with Begin, staged with the other methods on the returned calculated order
+// and applied to the order with Commit. Edits rejected by Shopify return a
+// GraphQLMutationError.
+// See: https://shopify.dev/docs/apps/fulfillment/order-management-apps/order-editing
+type OrderEditService interface {
+	Begin(int64) (*OrderEditResult, error)
+	AddVariant(string, OrderEditVariant) (*OrderEditResult, error)
+	AddCustomItem(string, OrderEditCustomItem) (*OrderEditResult, error)
+	SetQuantity(string, string, int, bool) (*OrderEditResult, error)
+	AddLineItemDiscount(string, string, OrderEditDiscount) (*OrderEditResult, error)
+	Commit(string, bool, string) (*Order, error)
+}
+
+// OrderEditServiceOp handles communication with the order editing related
+// mutations of the Shopify API.
+type OrderEditServiceOp struct {
+	client *Client
+}
+
+// CalculatedOrder is an order with the changes staged by an order edit.
+// IDs are GraphQL global IDs.
+type CalculatedOrder struct {
+	ID                        string              `json:"id"`
+	SubtotalLineItemsQuantity int                 `json:"subtotal_line_items_quantity"`
+	SubtotalPriceSet          *AmountSet          `json:"subtotal_price_set"`
+	CartDiscountAmountSet     *AmountSet          `json:"cart_discount_amount_set"`
+	TotalPriceSet             *AmountSet          `json:"total_price_set"`
+	TotalOutstandingSet       *AmountSet          `json:"total_outstanding_set"`
+	LineItems                 CalculatedLineItems `json:"line_items"`
+	AddedLineItems            CalculatedLineItems `json:"added_line_items"`
+}
+
+// CalculatedLineItem is a line item of a calculated order. Variant is nil for
+// custom items.
+type CalculatedLineItem struct {
+	ID                        string             `json:"id"`
+	Title                     string             `json:"title"`
+	SKU                       string             `json:"sku"`
+	Quantity                  int                `json:"quantity"`
+	EditableQuantity          int                `json:"editable_quantity"`
+	Restockable               bool               `json:"restockable"`
+	Restocking                bool               `json:"restocking"`
+	HasStagedLineItemDiscount bool               `json:"has_staged_line_item_discount"`
+	Variant                   *CalculatedVariant `json:"variant"`
+	OriginalUnitPriceSet      *AmountSet         `json:"original_unit_price_set"`
+	DiscountedUnitPriceSet    *AmountSet         `json:"discounted_unit_price_set"`
+	EditableSubtotalSet       *AmountSet         `json:"editable_subtotal_set"`
+}
+
+// CalculatedLineItems are the line items of a calculated order, decoded from
+// a GraphQL connection
+type CalculatedLineItems []CalculatedLineItem
+
+// UnmarshalJSON implements json.Unmarshaler
+func (items *CalculatedLineItems) UnmarshalJSON(data []byte) error {
+	return unmarshalConnection(data, (*[]CalculatedLineItem)(items))
+}
+
+// CalculatedVariant is the variant of a calculated line item
+type CalculatedVariant struct {
+	ID        string `json:"id"`
+	VariantID int64  `json:"legacy_resource_id,string"`
+}
+
+// OrderEditVariant is a variant to add to an order. LocationID is the
+// location to fulfill it from, and AllowDuplicates adds it even if the order
+// already has the variant.
+type OrderEditVariant struct {
+	VariantID       int64
+	Quantity        int
+	LocationID      int64
+	AllowDuplicates bool
+}
+
+// OrderEditCustomItem is an item without a variant to add to an order. The
+// price must have a currency code, the order's presentment currency.
+type OrderEditCustomItem struct {
+	Title            string
+	Price            Money
+	Quantity         int
+	Taxable          bool
+	RequiresShipping bool
+	LocationID       int64
+}
+
+// OrderEditDiscount is a discount on a line item, of either a FixedValue or
+// a PercentValue.
+type OrderEditDiscount struct {
+	Description  string
+	FixedValue   *Money
+	PercentValue *float64
+}
+
+// OrderEditResult is the result of a staged order edit: the calculated order
+// and, for changes to a line item, the calculated line item.
+type OrderEditResult struct {
+	CalculatedOrder    *CalculatedOrder    `json:"calculated_order"`
+	CalculatedLineItem *CalculatedLineItem `json:"calculated_line_item"`
+}
+
+// moneyInput returns the GraphQL input for money, which requires a currency
+func moneyInput(money Money) (*MoneyInput, error) {
+	if money.CurrencyCode == "" {
+		return nil, errors.New("money must have a currency code")
+	}
+	amount := money.Decimal
+	return &MoneyInput{Amount: &amount, CurrencyCode: money.CurrencyCode}, nil
+}
+
+const orderEditMoneyBagFragment = `
+fragment moneyBag on MoneyBag {
+  shop_money: shopMoney { amount currency_code: currencyCode }
+  presentment_money: presentmentMoney { amount currency_code: currencyCode }
+}`
+
+const orderEditLineItemFragment = `
+fragment calculatedLineItem on CalculatedLineItem {
+  id
+  title
+  sku
+  quantity
+  editable_quantity: editableQuantity
+  restockable
+  restocking
+  has_staged_line_item_discount: hasStagedLineItemDiscount
+  variant { id legacy_resource_id: legacyResourceId }
+  original_unit_price_set: originalUnitPriceSet { ...moneyBag }
+  discounted_unit_price_set: discountedUnitPriceSet { ...moneyBag }
+  editable_subtotal_set: editableSubtotalSet { ...moneyBag }
+}`
+
+const orderEditOrderFragment = `
+fragment calculatedOrder on CalculatedOrder {
+  id
+  subtotal_line_items_quantity: subtotalLineItemsQuantity
+  subtotal_price_set: subtotalPriceSet { ...moneyBag }
+  cart_discount_amount_set: cartDiscountAmountSet { ...moneyBag }
+  total_price_set: totalPriceSet { ...moneyBag }
+  total_outstanding_set: totalOutstandingSet { ...moneyBag }
+  line_items: lineItems(first: 250) { edges { node { ...calculatedLineItem } } }
+  added_line_items: addedLineItems(first: 250) { edges { node { ...calculatedLineItem } } }
+}`
+
+const orderEditFragments = orderEditOrderFragment + orderEditLineItemFragment + orderEditMoneyBagFragment
+
+// orderEditMutation runs an order edit mutation returning the calculated order
+// and line item, and returns its user errors as a GraphQLMutationError.
+func (s *OrderEditServiceOp) orderEditMutation(mutation, arguments, parameters string, variables interface{}) (*OrderEditResult, error) {
+	lineItem := "calculated_line_item: calculatedLineItem { ...calculatedLineItem }"
+	if mutation == "orderEditBegin" {
+		lineItem = ""
+	}
+
+	query := fmt.Sprintf(`mutation %[1]s(%[2]s) {
+  result: %[1]s(%[3]s) {
+    calculated_order: calculatedOrder { ...calculatedOrder }
+    %[4]s
+    user_errors: userErrors { field message }
+  }
+}`, mutation, arguments, parameters, lineItem) + orderEditFragments
+
+	resource := struct {
+		Result struct {
+			OrderEditResult
+			UserErrors []GraphQLUserError `json:"user_errors"`
+		} `json:"result"`
+	}{}
+	err := s.client.graphQL(query, variables, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resource.Result.UserErrors) > 0 {
+		return nil, GraphQLMutationError{Mutation: mutation, UserErrors: resource.Result.UserErrors}
+	}
+	return &resource.Result.OrderEditResult, nil
+}
+
+// Begin editing an order, returning the calculated order to stage changes on
+func (s *OrderEditServiceOp) Begin(orderID int64) (*OrderEditResult, error) {
+	variables := struct {
+		ID string `json:"id"`
+	}{graphQLID("Order", orderID)}
+	return s.orderEditMutation("orderEditBegin", "$id: ID!", "id: $id", variables)
+}
+
+// AddVariant stages adding a quantity of a variant to a calculated order
+func (s *OrderEditServiceOp) AddVariant(calculatedOrderID string, variant OrderEditVariant) (*OrderEditResult, error) {
+	variables := struct {
+		ID              string `json:"id"`
+		VariantID       string `json:"variantId"`
+		Quantity        int    `json:"quantity"`
+		LocationID      string `json:"locationId,omitempty"`
+		AllowDuplicates bool   `json:"allowDuplicates"`
+	}{
+		ID:              calculatedOrderID,
+		VariantID:       graphQLID("ProductVariant", variant.VariantID),
+		Quantity:        variant.Quantity,
+		AllowDuplicates: variant.AllowDuplicates,
+	}
+	if variant.LocationID != 0 {
+		variables.LocationID = graphQLID("Location", variant.LocationID)
+	}
+
+	return s.orderEditMutation("orderEditAddVariant",
+		"$id: ID!, $variantId: ID!, $quantity: Int!, $locationId: ID, $allowDuplicates: Boolean",
+		"id: $id, variantId: $variantId, quantity: $quantity, locationId: $locationId, allowDuplicates: $allowDuplicates",
+		variables)
+}
+
+// AddCustomItem stages adding an item without a variant to a calculated order
+func (s *OrderEditServiceOp) AddCustomItem(calculatedOrderID string, item OrderEditCustomItem) (*OrderEditResult, error) {
+	price, err := moneyInput(item.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := struct {
+		ID               string      `json:"id"`
+		Title            string      `json:"title"`
+		Price            *MoneyInput `json:"price"`
+		Quantity         int         `json:"quantity"`
+		Taxable          bool        `json:"taxable"`
+		RequiresShipping bool        `json:"requiresShipping"`
+		LocationID       string      `json:"locationId,omitempty"`
+	}{
+		ID:               calculatedOrderID,
+		Title:            item.Title,
+		Price:            price,
+		Quantity:         item.Quantity,
+		Taxable:          item.Taxable,
+		RequiresShipping: item.RequiresShipping,
+	}
+	if item.LocationID != 0 {
+		variables.LocationID = graphQLID("Location", item.LocationID)
+	}
+
+	return s.orderEditMutation("orderEditAddCustomItem",
+		"$id: ID!, $title: String!, $price: MoneyInput!, $quantity: Int!, $taxable: Boolean, $requiresShipping: Boolean, $locationId: ID",
+		"id: $id, title: $title, price: $price, quantity: $quantity, taxable: $taxable, requiresShipping: $requiresShipping, locationId: $locationId",
+		variables)
+}
+
+// SetQuantity stages setting the quantity of a line item of a calculated
+// order. Setting it to 0 removes the line item, restocking it if restock is
+// set.
+func (s *OrderEditServiceOp) SetQuantity(calculatedOrderID string, lineItemID string, quantity int, restock bool) (*OrderEditResult, error) {
+	variables := struct {
+		ID         string `json:"id"`
+		LineItemID string `json:"lineItemId"`
+		Quantity   int    `json:"quantity"`
+		Restock    bool   `json:"restock"`
+	}{calculatedOrderID, lineItemID, quantity, restock}
+
+	return s.orderEditMutation("orderEditSetQuantity",
+		"$id: ID!, $lineItemId: ID!, $quantity: Int!, $restock: Boolean",
+		"id: $id, lineItemId: $lineItemId, quantity: $quantity, restock: $restock",
+		variables)
+}
+
+// AddLineItemDiscount stages a discount on a line item added to a calculated
+// order
+func (s *OrderEditServiceOp) AddLineItemDiscount(calculatedOrderID string, lineItemID string, discount OrderEditDiscount) (*OrderEditResult, error) {
+	if (discount.FixedValue == nil) == (discount.PercentValue == nil) {
+		return nil, errors.New("order edit discount must have either a fixed or a percent value")
+	}
+
+	input := struct {
+		Description  string      `json:"description,omitempty"`
+		FixedValue   *MoneyInput `json:"fixedValue,omitempty"`
+		PercentValue *float64    `json:"percentValue,omitempty"`
+	}{Description: discount.Description, PercentValue: discount.PercentValue}
+	if discount.FixedValue != nil {
+		fixedValue, err := moneyInput(*discount.FixedValue)
+		if err != nil {
+			return nil, err
+		}
+		input.FixedValue = fixedValue
+	}
+
+	variables := struct {
+		ID         string      `json:"id"`
+		LineItemID string      `json:"lineItemId"`
+		Discount   interface{} `json:"discount"`
+	}{calculatedOrderID, lineItemID, input}
+
+	return s.orderEditMutation("orderEditAddLineItemDiscount",
+		"$id: ID!, $lineItemId: ID!, $discount: OrderEditAppliedDiscountInput!",
+		"id: $id, lineItemId: $lineItemId, discount: $discount",
+		variables)
+}
+
+// Commit the changes staged on a calculated order to the order, notifying the
+// customer if notifyCustomer is set. The returned order only has its ID and
+// name set.
+func (s *OrderEditServiceOp) Commit(calculatedOrderID string, notifyCustomer bool, staffNote string) (*Order, error) {
+	query := `mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+  result: orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote) {
+    order { legacy_resource_id: legacyResourceId name }
+    user_errors: userErrors { field message }
+  }
+}`
+	variables := struct {
+		ID             string `json:"id"`
+		NotifyCustomer bool   `json:"notifyCustomer"`
+		StaffNote      string `json:"staffNote,omitempty"`
+	}{calculatedOrderID, notifyCustomer, staffNote}
+
+	resource := struct {
+		Result struct {
+			Order *struct {
+				ID   int64  `json:"legacy_resource_id,string"`
+				Name string `json:"name"`
+			} `json:"order"`
+			UserErrors []GraphQLUserError `json:"user_errors"`
+		} `json:"result"`
+	}{}
+	err := s.client.graphQL(query, variables, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resource.Result.UserErrors) > 0 {
+		return nil, GraphQLMutationError{Mutation: "orderEditCommit", UserErrors: resource.Result.UserErrors}
+	}
+	if resource.Result.Order == nil {
+		return nil, fmt.Errorf("orderEditCommit returned no order for %s", calculatedOrderID)
+	}
+	return &Order{ID: resource.Result.Order.ID, Name: resource.Result.Order.Name}, nil
+}
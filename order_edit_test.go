@@ -0,0 +1,272 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func checkOrderEditRequest(t *testing.T, request graphQLRequest, mutation string, expectedVariables map[string]interface{}) {
+	if !strings.HasPrefix(request.Query, fmt.Sprintf("mutation %s(", mutation)) {
+		t.Errorf("OrderEdit sent query %s, expected mutation %s", request.Query, mutation)
+	}
+	if !reflect.DeepEqual(request.Variables, expectedVariables) {
+		t.Errorf("OrderEdit sent variables %+v, expected %+v", request.Variables, expectedVariables)
+	}
+}
+
+func TestOrderEditBegin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/begin.json")
+
+	result, err := client.OrderEdit.Begin(450789469)
+	if err != nil {
+		t.Fatalf("OrderEdit.Begin returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditBegin", map[string]interface{}{
+		"id": "gid://shopify/Order/450789469",
+	})
+
+	order := result.CalculatedOrder
+	if order == nil || order.ID != "gid://shopify/CalculatedOrder/17719017" || order.SubtotalLineItemsQuantity != 1 {
+		t.Fatalf("OrderEdit.Begin returned %+v", order)
+	}
+	if result.CalculatedLineItem != nil {
+		t.Errorf("OrderEdit.Begin returned line item %+v, expected nil", result.CalculatedLineItem)
+	}
+	if total, ok := order.TotalPriceSet.In("USD"); !ok || !total.Equal(decimal.New(199, 0)) {
+		t.Errorf("CalculatedOrder.TotalPriceSet returned %+v", order.TotalPriceSet)
+	}
+	if len(order.LineItems) != 1 || len(order.AddedLineItems) != 0 {
+		t.Fatalf("CalculatedOrder returned line items %+v, added %+v", order.LineItems, order.AddedLineItems)
+	}
+
+	lineItem := order.LineItems[0]
+	if lineItem.ID != "gid://shopify/CalculatedLineItem/466157049" || lineItem.SKU != "IPOD2008GREEN" ||
+		lineItem.EditableQuantity != 1 || !lineItem.Restockable {
+		t.Errorf("CalculatedOrder.LineItems[0] returned %+v", lineItem)
+	}
+	if lineItem.Variant == nil || lineItem.Variant.VariantID != 39072856 {
+		t.Errorf("CalculatedLineItem.Variant returned %+v", lineItem.Variant)
+	}
+}
+
+func TestOrderEditAddVariant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/add_variant.json")
+
+	result, err := client.OrderEdit.AddVariant("gid://shopify/CalculatedOrder/17719017", OrderEditVariant{
+		VariantID:  457924702,
+		Quantity:   2,
+		LocationID: 905684977,
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddVariant returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditAddVariant", map[string]interface{}{
+		"id":              "gid://shopify/CalculatedOrder/17719017",
+		"variantId":       "gid://shopify/ProductVariant/457924702",
+		"quantity":        float64(2),
+		"locationId":      "gid://shopify/Location/905684977",
+		"allowDuplicates": false,
+	})
+
+	lineItem := result.CalculatedLineItem
+	if lineItem == nil || lineItem.ID != "gid://shopify/CalculatedLineItem/a1b2c3d4" || lineItem.Quantity != 2 {
+		t.Fatalf("OrderEdit.AddVariant returned line item %+v", lineItem)
+	}
+	if len(result.CalculatedOrder.AddedLineItems) != 1 {
+		t.Errorf("OrderEdit.AddVariant returned added line items %+v", result.CalculatedOrder.AddedLineItems)
+	}
+	if outstanding, ok := result.CalculatedOrder.TotalOutstandingSet.In("USD"); !ok || !outstanding.Equal(decimal.New(398, 0)) {
+		t.Errorf("CalculatedOrder.TotalOutstandingSet returned %+v", result.CalculatedOrder.TotalOutstandingSet)
+	}
+}
+
+func TestOrderEditAddCustomItem(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/add_variant.json")
+
+	_, err := client.OrderEdit.AddCustomItem("gid://shopify/CalculatedOrder/17719017", OrderEditCustomItem{
+		Title:            "Gift wrapping",
+		Price:            *NewMoney(decimal.New(5, 0), "USD"),
+		Quantity:         1,
+		RequiresShipping: true,
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddCustomItem returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditAddCustomItem", map[string]interface{}{
+		"id":               "gid://shopify/CalculatedOrder/17719017",
+		"title":            "Gift wrapping",
+		"price":            map[string]interface{}{"amount": "5", "currencyCode": "USD"},
+		"quantity":         float64(1),
+		"taxable":          false,
+		"requiresShipping": true,
+	})
+}
+
+func TestOrderEditAddCustomItemWithoutCurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.OrderEdit.AddCustomItem("gid://shopify/CalculatedOrder/17719017", OrderEditCustomItem{
+		Title:    "Gift wrapping",
+		Price:    *NewMoney(decimal.New(5, 0), ""),
+		Quantity: 1,
+	})
+	if err == nil {
+		t.Error("OrderEdit.AddCustomItem returned no error for a price without currency")
+	}
+}
+
+func TestOrderEditSetQuantity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/add_variant.json")
+
+	_, err := client.OrderEdit.SetQuantity("gid://shopify/CalculatedOrder/17719017", "gid://shopify/CalculatedLineItem/466157049", 0, true)
+	if err != nil {
+		t.Fatalf("OrderEdit.SetQuantity returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditSetQuantity", map[string]interface{}{
+		"id":         "gid://shopify/CalculatedOrder/17719017",
+		"lineItemId": "gid://shopify/CalculatedLineItem/466157049",
+		"quantity":   float64(0),
+		"restock":    true,
+	})
+}
+
+func TestOrderEditAddLineItemDiscount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/add_variant.json")
+
+	percent := 10.0
+	_, err := client.OrderEdit.AddLineItemDiscount("gid://shopify/CalculatedOrder/17719017", "gid://shopify/CalculatedLineItem/a1b2c3d4",
+		OrderEditDiscount{Description: "Loyalty", PercentValue: &percent})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddLineItemDiscount returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditAddLineItemDiscount", map[string]interface{}{
+		"id":         "gid://shopify/CalculatedOrder/17719017",
+		"lineItemId": "gid://shopify/CalculatedLineItem/a1b2c3d4",
+		"discount":   map[string]interface{}{"description": "Loyalty", "percentValue": float64(10)},
+	})
+
+	_, err = client.OrderEdit.AddLineItemDiscount("gid://shopify/CalculatedOrder/17719017", "gid://shopify/CalculatedLineItem/a1b2c3d4",
+		OrderEditDiscount{FixedValue: NewMoney(decimal.New(5, 0), "USD")})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddLineItemDiscount returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditAddLineItemDiscount", map[string]interface{}{
+		"id":         "gid://shopify/CalculatedOrder/17719017",
+		"lineItemId": "gid://shopify/CalculatedLineItem/a1b2c3d4",
+		"discount":   map[string]interface{}{"fixedValue": map[string]interface{}{"amount": "5", "currencyCode": "USD"}},
+	})
+
+	_, err = client.OrderEdit.AddLineItemDiscount("gid://shopify/CalculatedOrder/17719017", "gid://shopify/CalculatedLineItem/a1b2c3d4",
+		OrderEditDiscount{Description: "Nothing"})
+	if err == nil {
+		t.Error("OrderEdit.AddLineItemDiscount returned no error for a discount without value")
+	}
+}
+
+func TestOrderEditUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/user_errors.json")
+
+	_, err := client.OrderEdit.SetQuantity("gid://shopify/CalculatedOrder/17719017", "gid://shopify/CalculatedLineItem/466157049", -1, false)
+
+	expected := GraphQLMutationError{
+		Mutation:   "orderEditSetQuantity",
+		UserErrors: []GraphQLUserError{{Field: []string{"quantity"}, Message: "Quantity must be greater than 0"}},
+	}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("OrderEdit.SetQuantity returned error %#v, expected %#v", err, expected)
+	}
+
+	expectedMessage := "orderEditSetQuantity failed: quantity: Quantity must be greater than 0"
+	if err != nil && err.Error() != expectedMessage {
+		t.Errorf("GraphQLMutationError.Error returned %s, expected %s", err.Error(), expectedMessage)
+	}
+}
+
+func TestOrderEditCommit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/commit.json")
+
+	order, err := client.OrderEdit.Commit("gid://shopify/CalculatedOrder/17719017", true, "Added gift wrapping")
+	if err != nil {
+		t.Fatalf("OrderEdit.Commit returned error: %v", err)
+	}
+
+	checkOrderEditRequest(t, request, "orderEditCommit", map[string]interface{}{
+		"id":             "gid://shopify/CalculatedOrder/17719017",
+		"notifyCustomer": true,
+		"staffNote":      "Added gift wrapping",
+	})
+
+	expected := &Order{ID: 450789469, Name: "#1001"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("OrderEdit.Commit returned %+v, expected %+v", order, expected)
+	}
+}
+
+func TestOrderEditCommitUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "order_edit/user_errors.json")
+
+	_, err := client.OrderEdit.Commit("gid://shopify/CalculatedOrder/17719017", false, "")
+	if editErr, ok := err.(GraphQLMutationError); !ok || editErr.Mutation != "orderEditCommit" {
+		t.Errorf("OrderEdit.Commit returned error %#v, expected a GraphQLMutationError", err)
+	}
+	if _, ok := request.Variables["staffNote"]; ok {
+		t.Errorf("OrderEdit.Commit sent an empty staff note: %+v", request.Variables)
+	}
+}
+
+func TestOrderEditCommitWithoutOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"result":{"order":null,"user_errors":[]}}}`))
+
+	order, err := client.OrderEdit.Commit("gid://shopify/CalculatedOrder/17719017", false, "")
+	if err == nil || order != nil {
+		t.Errorf("OrderEdit.Commit returned %+v, %v, expected an error", order, err)
+	}
+}
@@ -0,0 +1,187 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderRiskRecommendation is the action recommended by an order risk
+type OrderRiskRecommendation string
+
+// Recommendations of an order risk, from the least to the most risky
+const (
+	OrderRiskAccept      OrderRiskRecommendation = "accept"
+	OrderRiskInvestigate OrderRiskRecommendation = "investigate"
+	OrderRiskCancel      OrderRiskRecommendation = "cancel"
+)
+
+// Sources of an order risk
+const (
+	OrderRiskSourceInternal = "Internal"
+	OrderRiskSourceExternal = "External"
+)
+
+// OrderRiskService is an interface for interfacing with the order risk
+// endpoints of the Shopify API, the fraud analysis of orders.
+// See: https://shopify.dev/docs/admin-api/rest/reference/orders/order-risk
+type OrderRiskService interface {
+	List(int64, interface{}) ([]OrderRisk, error)
+	Get(int64, int64, interface{}) (*OrderRisk, error)
+	Create(int64, OrderRisk) (*OrderRisk, error)
+	Update(int64, OrderRisk) (*OrderRisk, error)
+	Delete(int64, int64) error
+	ListOrdersWithHighestRisk(interface{}) ([]OrderWithRisk, *Pagination, error)
+}
+
+// OrderRiskServiceOp handles communication with the order risk related
+// methods of the Shopify API.
+type OrderRiskServiceOp struct {
+	client *Client
+}
+
+// OrderRisk represents a Shopify order risk. Score is between 0 and 1, and
+// CauseCancel tells whether the risk caused the order to be cancelled.
+type OrderRisk struct {
+	ID              int64                   `json:"id,omitempty"`
+	OrderID         int64                   `json:"order_id,omitempty"`
+	CheckoutID      int64                   `json:"checkout_id,omitempty"`
+	Source          string                  `json:"source,omitempty"`
+	Score           *decimal.Decimal        `json:"score,omitempty"`
+	Recommendation  OrderRiskRecommendation `json:"recommendation,omitempty"`
+	Display         bool                    `json:"display,omitempty"`
+	CauseCancel     bool                    `json:"cause_cancel,omitempty"`
+	Message         string                  `json:"message,omitempty"`
+	MerchantMessage string                  `json:"merchant_message,omitempty"`
+}
+
+// OrderWithRisk is an order with its highest risk, nil for orders without
+// risks
+type OrderWithRisk struct {
+	Order Order
+	Risk  *OrderRisk
+}
+
+// OrderRiskResource represents the result from the risks/X.json endpoint
+type OrderRiskResource struct {
+	Risk *OrderRisk `json:"risk"`
+}
+
+// OrderRisksResource represents the result from the risks.json endpoint
+type OrderRisksResource struct {
+	Risks []OrderRisk `json:"risks"`
+}
+
+// riskLevel ranks recommendations, unknown ones being the least risky
+func (r OrderRiskRecommendation) riskLevel() int {
+	switch r {
+	case OrderRiskCancel:
+		return 3
+	case OrderRiskInvestigate:
+		return 2
+	case OrderRiskAccept:
+		return 1
+	}
+	return 0
+}
+
+// decimalOrZero returns the decimal, zero if it's nil
+func decimalOrZero(d *decimal.Decimal) decimal.Decimal {
+	if d == nil {
+		return decimal.Zero
+	}
+	return *d
+}
+
+// HigherThan returns whether the risk is higher than other: risks that caused
+// the order to be cancelled first, then by recommendation, then by score.
+func (r OrderRisk) HigherThan(other OrderRisk) bool {
+	if r.CauseCancel != other.CauseCancel {
+		return r.CauseCancel
+	}
+	if r.Recommendation.riskLevel() != other.Recommendation.riskLevel() {
+		return r.Recommendation.riskLevel() > other.Recommendation.riskLevel()
+	}
+	return decimalOrZero(r.Score).GreaterThan(decimalOrZero(other.Score))
+}
+
+// HighestRisk returns the highest of the risks, or nil if there are none
+func HighestRisk(risks []OrderRisk) *OrderRisk {
+	var highest *OrderRisk
+	for i := range risks {
+		if highest == nil || risks[i].HigherThan(*highest) {
+			highest = &risks[i]
+		}
+	}
+	return highest
+}
+
+func orderRisksPath(orderID int64) string {
+	return fmt.Sprintf("%s/%d/risks", ordersBasePath, orderID)
+}
+
+// List risks of an order
+func (s *OrderRiskServiceOp) List(orderID int64, options interface{}) ([]OrderRisk, error) {
+	path := fmt.Sprintf("%s.json", orderRisksPath(orderID))
+	resource := new(OrderRisksResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Risks, err
+}
+
+// Get individual order risk
+func (s *OrderRiskServiceOp) Get(orderID int64, riskID int64, options interface{}) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d.json", orderRisksPath(orderID), riskID)
+	resource := new(OrderRiskResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Risk, err
+}
+
+// Create a new order risk
+func (s *OrderRiskServiceOp) Create(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s.json", orderRisksPath(orderID))
+	wrappedData := OrderRiskResource{Risk: &risk}
+	resource := new(OrderRiskResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Update an existing order risk
+func (s *OrderRiskServiceOp) Update(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d.json", orderRisksPath(orderID), risk.ID)
+	wrappedData := OrderRiskResource{Risk: &risk}
+	resource := new(OrderRiskResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Delete an existing order risk
+func (s *OrderRiskServiceOp) Delete(orderID int64, riskID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", orderRisksPath(orderID), riskID))
+}
+
+// ListOrdersWithHighestRisk lists a page of orders, each with its highest
+// risk, and returns pagination to retrieve next/previous results.
+//
+// Shopify has no bulk endpoint for risks, so besides listing the orders it
+// makes one risks request per order: a page of 50 orders costs 51 calls
+// against the REST rate limit bucket, which holds 40 calls and leaks 2 per
+// second on standard plans. Keep pages small with ListOptions.Limit, or pick
+// orders with ListOptions.IDs, and set ListOptions.Fields to the fields needed,
+// including "id".
+func (s *OrderRiskServiceOp) ListOrdersWithHighestRisk(options interface{}) ([]OrderWithRisk, *Pagination, error) {
+	orders, pagination, err := s.client.Order.ListWithPagination(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ordersWithRisk := make([]OrderWithRisk, 0, len(orders))
+	for _, order := range orders {
+		risks, err := s.List(order.ID, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		ordersWithRisk = append(ordersWithRisk, OrderWithRisk{Order: order, Risk: HighestRisk(risks)})
+	}
+
+	return ordersWithRisk, pagination, nil
+}
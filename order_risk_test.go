@@ -0,0 +1,196 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func orderRiskTests(t *testing.T, risk *OrderRisk) {
+	if risk == nil {
+		t.Fatal("OrderRisk is nil")
+	}
+
+	score := decimal.RequireFromString("1.0")
+	expected := &OrderRisk{
+		ID:              284138680,
+		OrderID:         450789469,
+		Source:          OrderRiskSourceExternal,
+		Score:           &score,
+		Recommendation:  OrderRiskCancel,
+		Display:         true,
+		CauseCancel:     true,
+		Message:         "This order came from an anonymous proxy",
+		MerchantMessage: "This order came from an anonymous proxy",
+	}
+	if risk.Score == nil || !risk.Score.Equal(score) {
+		t.Errorf("OrderRisk.Score returned %v, expected %v", risk.Score, score)
+	}
+	actual := *risk
+	actual.Score, expected.Score = nil, nil
+	if !reflect.DeepEqual(&actual, expected) {
+		t.Errorf("OrderRisk returned %+v, expected %+v", risk, expected)
+	}
+}
+
+func TestOrderRiskList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risks.json")))
+
+	risks, err := client.OrderRisk.List(450789469, nil)
+	if err != nil {
+		t.Errorf("OrderRisk.List returned error: %v", err)
+	}
+
+	if len(risks) != 2 || risks[0].Recommendation != OrderRiskInvestigate || risks[0].CheckoutID != 901414060 {
+		t.Fatalf("OrderRisk.List returned %+v", risks)
+	}
+	orderRiskTests(t, &risks[1])
+}
+
+func TestOrderRiskGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risk.json")))
+
+	risk, err := client.OrderRisk.Get(450789469, 284138680, nil)
+	if err != nil {
+		t.Errorf("OrderRisk.Get returned error: %v", err)
+	}
+
+	orderRiskTests(t, risk)
+}
+
+func TestOrderRiskCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("order_risk.json")), nil
+		})
+
+	score := decimal.RequireFromString("1.0")
+	risk, err := client.OrderRisk.Create(450789469, OrderRisk{
+		Message:        "This order came from an anonymous proxy",
+		Recommendation: OrderRiskCancel,
+		Score:          &score,
+		Source:         OrderRiskSourceExternal,
+		CauseCancel:    true,
+		Display:        true,
+	})
+	if err != nil {
+		t.Errorf("OrderRisk.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"risk":{"source":"External","score":"1","recommendation":"cancel","display":true,"cause_cancel":true,"message":"This order came from an anonymous proxy"}}`
+	if body != expectedBody {
+		t.Errorf("OrderRisk.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	orderRiskTests(t, risk)
+}
+
+func TestOrderRiskUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risk.json")))
+
+	risk, err := client.OrderRisk.Update(450789469, OrderRisk{ID: 284138680, Recommendation: OrderRiskCancel})
+	if err != nil {
+		t.Errorf("OrderRisk.Update returned error: %v", err)
+	}
+
+	orderRiskTests(t, risk)
+}
+
+func TestOrderRiskDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.OrderRisk.Delete(450789469, 284138680)
+	if err != nil {
+		t.Errorf("OrderRisk.Delete returned error: %v", err)
+	}
+}
+
+func TestHighestRisk(t *testing.T) {
+	low := decimal.RequireFromString("0.2")
+	high := decimal.RequireFromString("0.9")
+
+	cases := []struct {
+		risks    []OrderRisk
+		expected int64
+	}{
+		{nil, 0},
+		{[]OrderRisk{{ID: 1, Recommendation: OrderRiskAccept}, {ID: 2, Recommendation: OrderRiskInvestigate}}, 2},
+		{[]OrderRisk{{ID: 1, Recommendation: OrderRiskInvestigate, Score: &low}, {ID: 2, Recommendation: OrderRiskInvestigate, Score: &high}}, 2},
+		{[]OrderRisk{{ID: 1, Recommendation: OrderRiskCancel, Score: &high}, {ID: 2, Recommendation: OrderRiskAccept, CauseCancel: true}}, 2},
+		{[]OrderRisk{{ID: 1, Recommendation: OrderRiskCancel}, {ID: 2, Recommendation: "unknown", Score: &high}}, 1},
+	}
+
+	for i, c := range cases {
+		risk := HighestRisk(c.risks)
+		if c.expected == 0 {
+			if risk != nil {
+				t.Errorf("HighestRisk (%d) returned %+v, expected nil", i, risk)
+			}
+			continue
+		}
+		if risk == nil || risk.ID != c.expected {
+			t.Errorf("HighestRisk (%d) returned %+v, expected risk %d", i, risk, c.expected)
+		}
+	}
+}
+
+func TestOrderRiskListOrdersWithHighestRisk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"orders": [{"id":450789469},{"id":450789470}]}`)
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risks.json")))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789470/risks.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"risks": []}`))
+
+	orders, pagination, err := client.OrderRisk.ListOrdersWithHighestRisk(OrderListOptions{ListOptions: ListOptions{Limit: 2}})
+	if err != nil {
+		t.Fatalf("OrderRisk.ListOrdersWithHighestRisk returned error: %v", err)
+	}
+
+	if len(orders) != 2 || orders[0].Order.ID != 450789469 || orders[1].Order.ID != 450789470 {
+		t.Fatalf("OrderRisk.ListOrdersWithHighestRisk returned %+v", orders)
+	}
+	orderRiskTests(t, orders[0].Risk)
+	if orders[1].Risk != nil {
+		t.Errorf("OrderRisk.ListOrdersWithHighestRisk returned risk %+v for an order without risks", orders[1].Risk)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "foo", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("OrderRisk.ListOrdersWithHighestRisk returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
@@ -167,7 +167,7 @@ func orderTests(t *testing.T, order Order) {
 
 	// Check prices
 	p := decimal.NewFromFloat(10)
-	if !p.Equals(*order.TotalPrice) {
+	if !p.Equals(order.TotalPrice.Decimal) {
 		t.Errorf("Order.TotalPrice returned %+v, expected %+v", order.TotalPrice, p)
 	}
 
@@ -186,7 +186,7 @@ func orderTests(t *testing.T, order Order) {
 
 	ptp := decimal.NewFromFloat(9)
 	lineItem := order.LineItems[0]
-	if !ptp.Equals(*lineItem.PreTaxPrice) {
+	if !ptp.Equals(lineItem.PreTaxPrice.Decimal) {
 		t.Errorf("Order.LineItems[0].PreTaxPrice, expected %v, actual %v", "9.00", lineItem.PreTaxPrice)
 	}
 }
@@ -291,6 +291,90 @@ func TestOrderGet(t *testing.T) {
 	orderTests(t, *order)
 }
 
+func TestOrderGetFull(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_full.json")))
+
+	order, err := client.Order.Get(450789469, nil)
+	if err != nil {
+		t.Fatalf("Order.Get returned error: %v", err)
+	}
+
+	closedAt := time.Date(2008, time.January, 10, 16, 0, 0, 0, time.UTC)
+	if order.ClosedAt == nil || !closedAt.Equal(*order.ClosedAt) {
+		t.Errorf("Order.ClosedAt returned %+v, expected %+v", order.ClosedAt, closedAt)
+	}
+
+	if order.OrderNumber != 1001 || order.Number != 1 || order.Note != "Leave at the door" || order.CancelReason != "" {
+		t.Errorf("Order returned %+v", order)
+	}
+
+	if order.Customer == nil || order.Customer.ID != 207119551 {
+		t.Errorf("Order.Customer returned %+v", order.Customer)
+	}
+
+	if order.BillingAddress == nil || order.BillingAddress.Address1 != "Chestnut Street 92" || order.BillingAddress.ProvinceCode != "KY" {
+		t.Errorf("Order.BillingAddress returned %+v", order.BillingAddress)
+	}
+	if order.ShippingAddress == nil || order.ShippingAddress.Latitude != 45.41634 {
+		t.Errorf("Order.ShippingAddress returned %+v", order.ShippingAddress)
+	}
+
+	if order.ClientDetails == nil || order.ClientDetails.BrowserIp != "0.0.0.0" {
+		t.Errorf("Order.ClientDetails returned %+v", order.ClientDetails)
+	}
+
+	expectedNoteAttributes := []NoteAttribute{{Name: "custom engraving", Value: "Happy Birthday"}}
+	if !reflect.DeepEqual(order.NoteAttributes, expectedNoteAttributes) {
+		t.Errorf("Order.NoteAttributes returned %+v, expected %+v", order.NoteAttributes, expectedNoteAttributes)
+	}
+
+	if !reflect.DeepEqual(order.PaymentGatewayNames, []string{"bogus"}) {
+		t.Errorf("Order.PaymentGatewayNames returned %+v", order.PaymentGatewayNames)
+	}
+
+	prices := map[string]*Money{
+		"598.94": order.TotalPrice,
+		"11.94":  order.TotalTax,
+		"10.00":  order.TotalDiscounts,
+		"597.00": order.TotalLineItemsPrice,
+	}
+	for expected, actual := range prices {
+		if actual == nil || !actual.Equal(decimal.RequireFromString(expected)) {
+			t.Errorf("Order price returned %v, expected %s", actual, expected)
+		}
+	}
+
+	if order.TotalPriceSet == nil || order.TotalPriceSet.ShopMoney.CurrencyCode != "USD" ||
+		!order.TotalPriceSet.PresentmentMoney.Amount.Equal(decimal.RequireFromString("598.94")) {
+		t.Errorf("Order.TotalPriceSet returned %+v", order.TotalPriceSet)
+	}
+
+	if len(order.ShippingLines) != 1 || order.ShippingLines[0].Title != "Free Shipping" {
+		t.Errorf("Order.ShippingLines returned %+v", order.ShippingLines)
+	}
+
+	if len(order.Fulfillments) != 1 || order.Fulfillments[0].TrackingNumber != "1Z2345" {
+		t.Errorf("Order.Fulfillments returned %+v", order.Fulfillments)
+	}
+
+	if len(order.Refunds) != 1 || len(order.Refunds[0].RefundLineItems) != 1 ||
+		order.Refunds[0].RefundLineItems[0].LineItemId != 703073504 {
+		t.Errorf("Order.Refunds returned %+v", order.Refunds)
+	}
+
+	if len(order.DiscountCodes) != 1 || order.DiscountCodes[0].Code != "TENOFF" {
+		t.Errorf("Order.DiscountCodes returned %+v", order.DiscountCodes)
+	}
+
+	if len(order.LineItems) != 1 || order.LineItems[0].Grams != 200 {
+		t.Errorf("Order.LineItems returned %+v", order.LineItems)
+	}
+}
+
 func TestOrderGetWithTransactions(t *testing.T) {
 	setup()
 	defer teardown()
@@ -954,7 +1038,7 @@ func testLineItem(t *testing.T, expected, actual LineItem) {
 			t.Errorf("LineItem.Price should be (%s), was (%s)", expected.Price, actual.Price)
 		}
 	} else {
-		if !actual.Price.Equals(*expected.Price) {
+		if !actual.Price.Equals(expected.Price.Decimal) {
 			t.Errorf("LineItem.Price should be (%s), was (%s)", expected.Price, actual.Price)
 		}
 	}
@@ -964,7 +1048,7 @@ func testLineItem(t *testing.T, expected, actual LineItem) {
 			t.Errorf("LineItem.TotalDiscount should be (%s), was (%s)", expected.TotalDiscount, actual.TotalDiscount)
 		}
 	} else {
-		if !actual.TotalDiscount.Equals(*expected.TotalDiscount) {
+		if !actual.TotalDiscount.Equals(expected.TotalDiscount.Decimal) {
 			t.Errorf("LineItem.TotalDiscount should be (%s), was (%s)", expected.TotalDiscount, actual.TotalDiscount)
 		}
 	}
@@ -1014,7 +1098,7 @@ func testLineItem(t *testing.T, expected, actual LineItem) {
 			t.Errorf("LineItem.PreTaxPrice should be (%v), was (%v)", expected.PreTaxPrice, actual.PreTaxPrice)
 		}
 	} else {
-		if !actual.PreTaxPrice.Equals(*expected.PreTaxPrice) {
+		if !actual.PreTaxPrice.Equals(expected.PreTaxPrice.Decimal) {
 			t.Errorf("LineItem.PreTaxPrice should be (%v), was (%v)", expected.PreTaxPrice, actual.PreTaxPrice)
 		}
 	}
@@ -1064,7 +1148,9 @@ func testLineItem(t *testing.T, expected, actual LineItem) {
 			t.Errorf("LineItem.AppliedDiscount should be (%v), was (%v)", expected.AppliedDiscount, actual.AppliedDiscount)
 		}
 	} else {
-		if *actual.AppliedDiscount != *expected.AppliedDiscount {
+		actualDiscount, expectedDiscount := *actual.AppliedDiscount, *expected.AppliedDiscount
+		actualDiscount.Amount, expectedDiscount.Amount = nil, nil
+		if actualDiscount != expectedDiscount || !actual.AppliedDiscount.Amount.Equal(expected.AppliedDiscount.Amount.Decimal) {
 			t.Errorf("LineItem.AppliedDiscount should be (%v), was (%v)", expected.AppliedDiscount, actual.AppliedDiscount)
 		}
 	}
@@ -1093,7 +1179,7 @@ func testTaxLines(t *testing.T, expected, actual []TaxLine) {
 		for i := 0; i < len(actual); i++ {
 			a := actual[i]
 			e := expected[i]
-			if !a.Price.Equals(*e.Price) {
+			if !a.Price.Equals(e.Price.Decimal) {
 				t.Errorf("LineItem.TaxLine[%d].Price should be (%s), was (%s)", i, e.Price, a.Price)
 			}
 			if !a.Rate.Equals(*e.Rate) {
@@ -1115,7 +1201,7 @@ func testShippingLines(t *testing.T, expected, actual ShippingLines) {
 		t.Errorf("ShippingLines.Title should be (%v), was (%v)", expected.Title, actual.Title)
 	}
 
-	if !actual.Price.Equals(*expected.Price) {
+	if !actual.Price.Equals(expected.Price.Decimal) {
 		t.Errorf("ShippingLines.Price should be (%v), was (%v)", expected.Price, actual.Price)
 	}
 
@@ -1172,13 +1258,14 @@ func validLineItem() LineItem {
 	tl2Price := decimal.New(1250, -2)
 	tl2Rate := decimal.New(5, -2)
 	discountAllocationAmount := decimal.New(55, -1)
+	appliedDiscountAmount := decimal.New(2500, -2)
 	return LineItem{
 		ID:                         int64(254721536),
 		ProductID:                  int64(111475476),
 		VariantID:                  int64(1234),
 		Quantity:                   1,
-		Price:                      &price,
-		TotalDiscount:              &totalDiscount,
+		Price:                      MoneyFromDecimal(&price),
+		TotalDiscount:              MoneyFromDecimal(&totalDiscount),
 		Title:                      "Soda Title",
 		VariantTitle:               "Test Variant",
 		Name:                       "Soda",
@@ -1189,7 +1276,7 @@ func validLineItem() LineItem {
 		FulfillmentService:         "manual",
 		RequiresShipping:           true,
 		VariantInventoryManagement: "shopify",
-		PreTaxPrice:                &preTaxPrice,
+		PreTaxPrice:                MoneyFromDecimal(&preTaxPrice),
 		Properties: []NoteAttribute{
 			NoteAttribute{
 				Name:  "note 1",
@@ -1207,12 +1294,12 @@ func validLineItem() LineItem {
 		TaxLines: []TaxLine{
 			TaxLine{
 				Title: "State tax",
-				Price: &tl1Price,
+				Price: MoneyFromDecimal(&tl1Price),
 				Rate:  &tl1Rate,
 			},
 			TaxLine{
 				Title: "Federal tax",
-				Price: &tl2Price,
+				Price: MoneyFromDecimal(&tl2Price),
 				Rate:  &tl2Rate,
 			},
 		},
@@ -1257,18 +1344,18 @@ func validLineItem() LineItem {
 			Description: "my test discount",
 			Value:       "0.05",
 			ValueType:   "percent",
-			Amount:      "25.00",
+			Amount:      MoneyFromDecimal(&appliedDiscountAmount),
 		},
 		DiscountAllocations: []DiscountAllocations{
 			{
-				Amount: &discountAllocationAmount,
+				Amount: MoneyFromDecimal(&discountAllocationAmount),
 				AmountSet: AmountSet{
 					ShopMoney: AmountSetEntry{
-						Amount:       &discountAllocationAmount,
+						Amount:       NewMoney(discountAllocationAmount, "EUR"),
 						CurrencyCode: "EUR",
 					},
 					PresentmentMoney: AmountSetEntry{
-						Amount:       &discountAllocationAmount,
+						Amount:       NewMoney(discountAllocationAmount, "EUR"),
 						CurrencyCode: "EUR",
 					},
 				},
@@ -1287,7 +1374,7 @@ func validShippingLines() ShippingLines {
 	return ShippingLines{
 		ID:                            int64(254721542),
 		Title:                         "Small Packet International Air",
-		Price:                         &price,
+		Price:                         MoneyFromDecimal(&price),
 		Code:                          "INT.TP",
 		Source:                        "canada_post",
 		Phone:                         "",
@@ -1297,12 +1384,12 @@ func validShippingLines() ShippingLines {
 		TaxLines: []TaxLine{
 			{
 				Title: "State tax",
-				Price: &tl1Price,
+				Price: MoneyFromDecimal(&tl1Price),
 				Rate:  &tl1Rate,
 			},
 			{
 				Title: "Federal tax",
-				Price: &tl2Price,
+				Price: MoneyFromDecimal(&tl2Price),
 				Rate:  &tl2Rate,
 			},
 		},
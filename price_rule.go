@@ -58,7 +58,7 @@ type PriceRule struct {
 }
 
 type prerequisiteSubtotalRange struct {
-	GreaterThanOrEqualTo string `json:"greater_than_or_equal_to,omitempty"`
+	GreaterThanOrEqualTo *Money `json:"greater_than_or_equal_to,omitempty"`
 }
 
 type prerequisiteQuantityRange struct {
@@ -66,7 +66,7 @@ type prerequisiteQuantityRange struct {
 }
 
 type prerequisiteShippingPriceRange struct {
-	LessThanOrEqualTo string `json:"less_than_or_equal_to,omitempty"`
+	LessThanOrEqualTo *Money `json:"less_than_or_equal_to,omitempty"`
 }
 
 type prerequisiteToEntitlementQuantityRatio struct {
@@ -89,12 +89,13 @@ func (pr *PriceRule) SetPrerequisiteSubtotalRange(greaterThanOrEqualTo *string)
 	if greaterThanOrEqualTo == nil {
 		pr.PrerequisiteSubtotalRange = nil
 	} else {
-		if !validateMoney(*greaterThanOrEqualTo) {
-			return fmt.Errorf("failed to parse value as Decimal, invalid value")
+		money, err := ParseMoney(*greaterThanOrEqualTo, "")
+		if err != nil {
+			return err
 		}
 
 		pr.PrerequisiteSubtotalRange = &prerequisiteSubtotalRange{
-			GreaterThanOrEqualTo: *greaterThanOrEqualTo,
+			GreaterThanOrEqualTo: money,
 		}
 	}
 
@@ -117,12 +118,13 @@ func (pr *PriceRule) SetPrerequisiteShippingPriceRange(lessThanOrEqualTo *string
 	if lessThanOrEqualTo == nil {
 		pr.PrerequisiteShippingPriceRange = nil
 	} else {
-		if !validateMoney(*lessThanOrEqualTo) {
-			return fmt.Errorf("failed to parse value as Decimal, invalid value")
+		money, err := ParseMoney(*lessThanOrEqualTo, "")
+		if err != nil {
+			return err
 		}
 
 		pr.PrerequisiteShippingPriceRange = &prerequisiteShippingPriceRange{
-			LessThanOrEqualTo: *lessThanOrEqualTo,
+			LessThanOrEqualTo: money,
 		}
 	}
 
@@ -191,8 +193,3 @@ func (s *PriceRuleServiceOp) Delete(priceRuleID int64) error {
 	err := s.client.Delete(path)
 	return err
 }
-
-func validateMoney(v string) bool {
-	_, err := decimal.NewFromString(v)
-	return err == nil
-}
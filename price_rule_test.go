@@ -156,7 +156,7 @@ func TestPriceRuleSetters(t *testing.T) {
 
 	pr.SetPrerequisiteToEntitlementQuantityRatio(&prereqRatioQuantity, &prereqRatioEntitledQuantity)
 
-	if pr.PrerequisiteSubtotalRange.GreaterThanOrEqualTo != prereqSubtotalRange {
+	if pr.PrerequisiteSubtotalRange.GreaterThanOrEqualTo.String() != prereqSubtotalRange {
 		t.Errorf("Failed to set prerequisite subtotal range: %s", prereqSubtotalRange)
 	}
 
@@ -164,7 +164,7 @@ func TestPriceRuleSetters(t *testing.T) {
 		t.Errorf("Failed to set prerequisite quantity range: %d", prereqQuantityRange)
 	}
 
-	if pr.PrerequisiteShippingPriceRange.LessThanOrEqualTo != prereqShippingPrice {
+	if pr.PrerequisiteShippingPriceRange.LessThanOrEqualTo.String() != prereqShippingPrice {
 		t.Errorf("Failed to set prerequisite shipping price: %s", prereqShippingPrice)
 	}
 
@@ -0,0 +1,172 @@
+package goshopify
+
+import (
+	"sync"
+	"time"
+)
+
+// PrivacyCustomer identifies the customer of a privacy webhook.
+type PrivacyCustomer struct {
+	ID    int64  `json:"id,omitempty"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// CustomerDataRequest is the payload of the customers/data_request webhook,
+// sent when a customer requests their data from a store owner.
+// See: https://shopify.dev/apps/webhooks/configuration/mandatory-webhooks
+type CustomerDataRequest struct {
+	ShopID          int64              `json:"shop_id,omitempty"`
+	ShopDomain      string             `json:"shop_domain,omitempty"`
+	OrdersRequested []int64            `json:"orders_requested,omitempty"`
+	Customer        PrivacyCustomer    `json:"customer"`
+	DataRequest     PrivacyDataRequest `json:"data_request"`
+}
+
+// PrivacyDataRequest identifies the data request of a customers/data_request
+// webhook.
+type PrivacyDataRequest struct {
+	ID int64 `json:"id,omitempty"`
+}
+
+// CustomerRedactRequest is the payload of the customers/redact webhook, sent
+// when a store owner requests the deletion of a customer's data.
+type CustomerRedactRequest struct {
+	ShopID         int64           `json:"shop_id,omitempty"`
+	ShopDomain     string          `json:"shop_domain,omitempty"`
+	Customer       PrivacyCustomer `json:"customer"`
+	OrdersToRedact []int64         `json:"orders_to_redact,omitempty"`
+}
+
+// ShopRedactRequest is the payload of the shop/redact webhook, sent 48 hours
+// after a store owner uninstalls the app.
+type ShopRedactRequest struct {
+	ShopID     int64  `json:"shop_id,omitempty"`
+	ShopDomain string `json:"shop_domain,omitempty"`
+}
+
+// PrivacyWebhookHandler is implemented by apps to handle the mandatory
+// privacy webhooks. Returning an error makes Shopify retry the delivery.
+type PrivacyWebhookHandler interface {
+	OnDataRequest(*WebhookDelivery, *CustomerDataRequest) error
+	OnCustomerRedact(*WebhookDelivery, *CustomerRedactRequest) error
+	OnShopRedact(*WebhookDelivery, *ShopRedactRequest) error
+}
+
+// PrivacyAuditRecord records the handling of a single privacy webhook.
+type PrivacyAuditRecord struct {
+	Topic      string
+	WebhookID  string
+	ShopID     int64
+	ShopDomain string
+	CustomerID int64
+	OrderIDs   []int64
+	HandledAt  time.Time
+	// Error is the error returned by the handler, empty on success
+	Error string
+}
+
+// PrivacyAuditLog keeps the audit records of privacy webhooks.
+type PrivacyAuditLog interface {
+	Record(PrivacyAuditRecord) error
+}
+
+// MemoryPrivacyAuditLog is an in-memory PrivacyAuditLog.
+type MemoryPrivacyAuditLog struct {
+	mu      sync.Mutex
+	records []PrivacyAuditRecord
+}
+
+// Record appends the record to the log.
+func (l *MemoryPrivacyAuditLog) Record(record PrivacyAuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, record)
+	return nil
+}
+
+// Records returns the records logged so far.
+func (l *MemoryPrivacyAuditLog) Records() []PrivacyAuditRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]PrivacyAuditRecord(nil), l.records...)
+}
+
+// HandlePrivacy registers the handlers of the customers/data_request,
+// customers/redact and shop/redact webhooks. Every delivery is recorded in the
+// audit log, when one is given, after the handler returns or, if its body
+// can't be decoded, before answering with 400 Bad Request.
+func (h *WebhookHandler) HandlePrivacy(handler PrivacyWebhookHandler, audit PrivacyAuditLog) {
+	h.Handle(func(d *WebhookDelivery) error {
+		resource := new(CustomerDataRequest)
+		if err := d.Decode(resource); err != nil {
+			return recordPrivacyAudit(audit, PrivacyAuditRecord{Topic: d.Topic, WebhookID: d.WebhookID}, err)
+		}
+		err := handler.OnDataRequest(d, resource)
+		return recordPrivacyAudit(audit, PrivacyAuditRecord{
+			Topic:      d.Topic,
+			WebhookID:  d.WebhookID,
+			ShopID:     resource.ShopID,
+			ShopDomain: resource.ShopDomain,
+			CustomerID: resource.Customer.ID,
+			OrderIDs:   resource.OrdersRequested,
+		}, err)
+	}, WebhookTopicCustomersDataRequest)
+
+	h.Handle(func(d *WebhookDelivery) error {
+		resource := new(CustomerRedactRequest)
+		if err := d.Decode(resource); err != nil {
+			return recordPrivacyAudit(audit, PrivacyAuditRecord{Topic: d.Topic, WebhookID: d.WebhookID}, err)
+		}
+		err := handler.OnCustomerRedact(d, resource)
+		return recordPrivacyAudit(audit, PrivacyAuditRecord{
+			Topic:      d.Topic,
+			WebhookID:  d.WebhookID,
+			ShopID:     resource.ShopID,
+			ShopDomain: resource.ShopDomain,
+			CustomerID: resource.Customer.ID,
+			OrderIDs:   resource.OrdersToRedact,
+		}, err)
+	}, WebhookTopicCustomersRedact)
+
+	h.Handle(func(d *WebhookDelivery) error {
+		resource := new(ShopRedactRequest)
+		if err := d.Decode(resource); err != nil {
+			return recordPrivacyAudit(audit, PrivacyAuditRecord{Topic: d.Topic, WebhookID: d.WebhookID}, err)
+		}
+		err := handler.OnShopRedact(d, resource)
+		return recordPrivacyAudit(audit, PrivacyAuditRecord{
+			Topic:      d.Topic,
+			WebhookID:  d.WebhookID,
+			ShopID:     resource.ShopID,
+			ShopDomain: resource.ShopDomain,
+		}, err)
+	}, WebhookTopicShopRedact)
+}
+
+// recordPrivacyAudit records the outcome of a privacy handler. A failure to
+// record is returned so that Shopify retries the delivery.
+func recordPrivacyAudit(audit PrivacyAuditLog, record PrivacyAuditRecord, handlerErr error) error {
+	if audit == nil {
+		return handlerErr
+	}
+
+	record.HandledAt = time.Now().UTC()
+	if handlerErr != nil {
+		record.Error = handlerErr.Error()
+	}
+
+	if err := audit.Record(record); err != nil && handlerErr == nil {
+		return err
+	}
+	return handlerErr
+}
+
+// NewPrivacyWebhookHandler returns a WebhookHandler serving only the mandatory
+// privacy webhooks. Requests with an invalid HMAC are answered with
+// 401 Unauthorized as Shopify requires.
+func NewPrivacyWebhookHandler(app App, handler PrivacyWebhookHandler, audit PrivacyAuditLog) *WebhookHandler {
+	h := NewWebhookHandler(app)
+	h.HandlePrivacy(handler, audit)
+	return h
+}
@@ -0,0 +1,133 @@
+package goshopify
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type testPrivacyHandler struct {
+	dataRequest    *CustomerDataRequest
+	customerRedact *CustomerRedactRequest
+	shopRedact     *ShopRedactRequest
+	err            error
+}
+
+func (h *testPrivacyHandler) OnDataRequest(d *WebhookDelivery, r *CustomerDataRequest) error {
+	h.dataRequest = r
+	return h.err
+}
+
+func (h *testPrivacyHandler) OnCustomerRedact(d *WebhookDelivery, r *CustomerRedactRequest) error {
+	h.customerRedact = r
+	return h.err
+}
+
+func (h *testPrivacyHandler) OnShopRedact(d *WebhookDelivery, r *ShopRedactRequest) error {
+	h.shopRedact = r
+	return h.err
+}
+
+func TestPrivacyWebhookHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	privacyHandler := new(testPrivacyHandler)
+	audit := new(MemoryPrivacyAuditLog)
+	handler := NewPrivacyWebhookHandler(app, privacyHandler, audit)
+
+	requests := []*http.Request{
+		newWebhookRequest("customers/data_request", `{"shop_id":954889,"shop_domain":"fooshop.myshopify.com","orders_requested":[299938,280263],"customer":{"id":191167,"email":"john@example.com","phone":"555-625-1199"},"data_request":{"id":9999}}`),
+		newWebhookRequest("customers/redact", `{"shop_id":954889,"shop_domain":"fooshop.myshopify.com","customer":{"id":191167,"email":"john@example.com","phone":"555-625-1199"},"orders_to_redact":[299938,280263]}`),
+		newWebhookRequest("shop/redact", `{"shop_id":954889,"shop_domain":"fooshop.myshopify.com"}`),
+	}
+	for _, req := range requests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Errorf("PrivacyWebhookHandler returned status %d for %s, expected %d", rec.Code, req.Header.Get("X-Shopify-Topic"), http.StatusOK)
+		}
+	}
+
+	customer := PrivacyCustomer{ID: 191167, Email: "john@example.com", Phone: "555-625-1199"}
+	expectedDataRequest := &CustomerDataRequest{
+		ShopID:          954889,
+		ShopDomain:      "fooshop.myshopify.com",
+		OrdersRequested: []int64{299938, 280263},
+		Customer:        customer,
+		DataRequest:     PrivacyDataRequest{ID: 9999},
+	}
+	if !reflect.DeepEqual(privacyHandler.dataRequest, expectedDataRequest) {
+		t.Errorf("OnDataRequest received %+v, expected %+v", privacyHandler.dataRequest, expectedDataRequest)
+	}
+
+	expectedCustomerRedact := &CustomerRedactRequest{
+		ShopID:         954889,
+		ShopDomain:     "fooshop.myshopify.com",
+		Customer:       customer,
+		OrdersToRedact: []int64{299938, 280263},
+	}
+	if !reflect.DeepEqual(privacyHandler.customerRedact, expectedCustomerRedact) {
+		t.Errorf("OnCustomerRedact received %+v, expected %+v", privacyHandler.customerRedact, expectedCustomerRedact)
+	}
+
+	expectedShopRedact := &ShopRedactRequest{ShopID: 954889, ShopDomain: "fooshop.myshopify.com"}
+	if !reflect.DeepEqual(privacyHandler.shopRedact, expectedShopRedact) {
+		t.Errorf("OnShopRedact received %+v, expected %+v", privacyHandler.shopRedact, expectedShopRedact)
+	}
+
+	records := audit.Records()
+	if len(records) != 3 {
+		t.Fatalf("MemoryPrivacyAuditLog has %d records, expected 3", len(records))
+	}
+	for i, topic := range []string{"customers/data_request", "customers/redact", "shop/redact"} {
+		record := records[i]
+		if record.Topic != topic || record.ShopID != 954889 || record.ShopDomain != "fooshop.myshopify.com" ||
+			record.WebhookID != "b54557e4-bdd9-4b37-8a5f-bf7d70bcd043" || record.HandledAt.IsZero() || record.Error != "" {
+			t.Errorf("PrivacyAuditRecord[%d] = %+v", i, record)
+		}
+	}
+	if records[1].CustomerID != 191167 || !reflect.DeepEqual(records[1].OrderIDs, []int64{299938, 280263}) {
+		t.Errorf("PrivacyAuditRecord[1] = %+v, expected customer and orders to redact", records[1])
+	}
+}
+
+func TestPrivacyWebhookHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	privacyHandler := &testPrivacyHandler{err: errors.New("test-error")}
+	audit := new(MemoryPrivacyAuditLog)
+	handler := NewPrivacyWebhookHandler(app, privacyHandler, audit)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("shop/redact", `{"shop_id":954889}`))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("PrivacyWebhookHandler returned status %d, expected %d", rec.Code, http.StatusInternalServerError)
+	}
+	if records := audit.Records(); len(records) != 1 || records[0].Error != "test-error" {
+		t.Errorf("MemoryPrivacyAuditLog has records %+v, expected the handler error", records)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("customers/redact", `{"shop_id":"954889"}`))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("PrivacyWebhookHandler returned status %d, expected %d", rec.Code, http.StatusBadRequest)
+	}
+	if records := audit.Records(); len(records) != 2 || records[1].Topic != "customers/redact" || records[1].Error == "" {
+		t.Errorf("MemoryPrivacyAuditLog has records %+v, expected the decoding error", records)
+	}
+
+	req := newWebhookRequest("shop/redact", `{"shop_id":954889}`)
+	req.Header.Set("X-Shopify-Hmac-Sha256", "hMTq0K2x7oyOjoBwGYeTj5oxfnaVYXzbanUG9aajpKI=")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("PrivacyWebhookHandler returned status %d, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+	if records := audit.Records(); len(records) != 2 {
+		t.Errorf("MemoryPrivacyAuditLog recorded an unverified request: %+v", records)
+	}
+}
@@ -30,20 +30,28 @@ type RecurringApplicationChargeServiceOp struct {
 
 // RecurringApplicationCharge represents a Shopify RecurringApplicationCharge.
 type RecurringApplicationCharge struct {
-	ConfirmationURL  string                   `json:"confirmation_url"`
-	ID               int64                    `json:"id"`
-	Name             string                   `json:"name"`
-	ReturnURL        string                   `json:"return_url"`
-	CurrencyCode     string                   `json:"currencyCode"`
-	Price            *decimal.Decimal         `json:"price"`
-	Interval         string                   `json:"interval"`
-	RecurringPricing *AppPlanRecurringPricing `json:"recurringPricing"`
-	CappedAmount     *decimal.Decimal         `json:"capped_amount"`
-	Terms            string                   `json:"terms"`
-	UsagePricing     *AppPlanUsagePricing     `json:"usagePricing"`
-	Status           string                   `json:"status"`
-	Test             *bool                    `json:"test"`
-	UpdatedAt        *time.Time               `json:"updated_at"`
+	APIClientID        int64                    `json:"api_client_id,omitempty"`
+	ActivatedOn        *time.Time               `json:"activated_on,omitempty"`
+	BillingOn          *time.Time               `json:"billing_on,omitempty"`
+	CancelledOn        *time.Time               `json:"cancelled_on,omitempty"`
+	ConfirmationURL    string                   `json:"confirmation_url"`
+	CreatedAt          *time.Time               `json:"created_at,omitempty"`
+	DecoratedReturnURL string                   `json:"decorated_return_url,omitempty"`
+	ID                 int64                    `json:"id"`
+	Name               string                   `json:"name"`
+	ReturnURL          string                   `json:"return_url"`
+	CurrencyCode       string                   `json:"currencyCode"`
+	Price              *Money                   `json:"price"`
+	Interval           string                   `json:"interval"`
+	RecurringPricing   *AppPlanRecurringPricing `json:"recurringPricing"`
+	CappedAmount       *Money                   `json:"capped_amount"`
+	Terms              string                   `json:"terms"`
+	UsagePricing       *AppPlanUsagePricing     `json:"usagePricing"`
+	Status             string                   `json:"status"`
+	Test               *bool                    `json:"test"`
+	TrialDays          int                      `json:"trial_days,omitempty"`
+	TrialEndsOn        *time.Time               `json:"trial_ends_on,omitempty"`
+	UpdatedAt          *time.Time               `json:"updated_at"`
 }
 
 // AppPlanRecurringPricing ... The pricing model input can be either appRecurringPricingDetails or appUsagePricingDetails.
@@ -107,6 +115,21 @@ func (r *RecurringApplicationCharge) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if err := parse(&r.ActivatedOn, aux.ActivatedOn); err != nil {
+		return err
+	}
+	if err := parse(&r.BillingOn, aux.BillingOn); err != nil {
+		return err
+	}
+	if err := parse(&r.CancelledOn, aux.CancelledOn); err != nil {
+		return err
+	}
+	if err := parse(&r.CreatedAt, aux.CreatedAt); err != nil {
+		return err
+	}
+	if err := parse(&r.TrialEndsOn, aux.TrialEndsOn); err != nil {
+		return err
+	}
 	if err := parse(&r.UpdatedAt, aux.UpdatedAt); err != nil {
 		return err
 	}
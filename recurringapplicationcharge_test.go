@@ -119,7 +119,7 @@ func TestRecurringApplicationChargeServiceOp_Create(t *testing.T) {
 	p := decimal.NewFromFloat(10.0)
 	charge := RecurringApplicationCharge{
 		Name:      "Super Duper Plan",
-		Price:     &p,
+		Price:     NewMoney(p, ""),
 		ReturnURL: "http://super-duper.shopifyapps.com",
 	}
 
@@ -289,7 +289,7 @@ func TestRecurringApplicationChargeServiceOp_Update(t *testing.T) {
 	}
 
 	ca := decimal.NewFromFloat(100.00)
-	expected := &RecurringApplicationCharge{ID: 455696195, CappedAmount: &ca}
+	expected := &RecurringApplicationCharge{ID: 455696195, CappedAmount: NewMoney(ca, "")}
 	if expected.CappedAmount.String() != charge.CappedAmount.String() {
 		t.Errorf("RecurringApplicationCharge.Update returned %+v, expected %+v", charge, expected)
 	}
@@ -0,0 +1,182 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+// How refunded line items are restocked
+const (
+	RestockTypeNoRestock = "no_restock"
+	RestockTypeCancel    = "cancel"
+	RestockTypeReturn    = "return"
+)
+
+// RefundService is an interface for interfacing with the refund endpoints of
+// the Shopify API. Refunds are previewed with Calculate, whose suggested
+// transactions are turned into a refund to Create with
+// NewRefundFromCalculation.
+// See: https://shopify.dev/docs/admin-api/rest/reference/orders/refund
+type RefundService interface {
+	List(int64, interface{}) ([]Refund, error)
+	ListWithPagination(int64, interface{}) ([]Refund, *Pagination, error)
+	Get(int64, int64, interface{}) (*Refund, error)
+	Calculate(int64, Refund) (*Refund, error)
+	Create(int64, Refund) (*Refund, error)
+}
+
+// RefundServiceOp handles communication with the refund related methods of
+// the Shopify API.
+type RefundServiceOp struct {
+	client *Client
+}
+
+// Refund represents a Shopify refund
+type Refund struct {
+	Id                int64             `json:"id,omitempty"`
+	OrderId           int64             `json:"order_id,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	ProcessedAt       *time.Time        `json:"processed_at,omitempty"`
+	Note              string            `json:"note,omitempty"`
+	Restock           bool              `json:"restock,omitempty"`
+	Notify            bool              `json:"notify,omitempty"`
+	UserId            int64             `json:"user_id,omitempty"`
+	Currency          string            `json:"currency,omitempty"`
+	Shipping          *RefundShipping   `json:"shipping,omitempty"`
+	RefundLineItems   []RefundLineItem  `json:"refund_line_items,omitempty"`
+	Transactions      []Transaction     `json:"transactions,omitempty"`
+	OrderAdjustments  []OrderAdjustment `json:"order_adjustments,omitempty"`
+	AdminGraphqlAPIID string            `json:"admin_graphql_api_id,omitempty"`
+}
+
+// RefundLineItem represents a line item of a refund. RestockType is one of
+// the RestockType constants, LocationID the location to restock at.
+type RefundLineItem struct {
+	Id          int64     `json:"id,omitempty"`
+	Quantity    int       `json:"quantity,omitempty"`
+	LineItemId  int64     `json:"line_item_id,omitempty"`
+	LineItem    *LineItem `json:"line_item,omitempty"`
+	RestockType string    `json:"restock_type,omitempty"`
+	LocationID  int64     `json:"location_id,omitempty"`
+	Price       *Money    `json:"price,omitempty"`
+	Subtotal    *Money    `json:"subtotal,omitempty"`
+	TotalTax    *Money    `json:"total_tax,omitempty"`
+}
+
+// RefundShipping is the shipping refunded, either in full or for Amount.
+// Calculated refunds also return the Tax and MaximumRefundable shipping.
+type RefundShipping struct {
+	FullRefund        bool   `json:"full_refund,omitempty"`
+	Amount            *Money `json:"amount,omitempty"`
+	Tax               *Money `json:"tax,omitempty"`
+	MaximumRefundable *Money `json:"maximum_refundable,omitempty"`
+}
+
+// OrderAdjustment represents an adjustment of an order made by a refund, e.g.
+// a shipping refund or a refund discrepancy
+type OrderAdjustment struct {
+	ID        int64  `json:"id,omitempty"`
+	OrderID   int64  `json:"order_id,omitempty"`
+	RefundID  int64  `json:"refund_id,omitempty"`
+	Amount    *Money `json:"amount,omitempty"`
+	TaxAmount *Money `json:"tax_amount,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// RefundResource represents the result from the refunds/X.json endpoint
+type RefundResource struct {
+	Refund *Refund `json:"refund"`
+}
+
+// RefundsResource represents the result from the refunds.json endpoint
+type RefundsResource struct {
+	Refunds []Refund `json:"refunds"`
+}
+
+// NewRefundFromCalculation returns the refund to create from a calculated
+// refund: the refunded line items and shipping, with the suggested
+// transactions turned into refund transactions.
+func NewRefundFromCalculation(calculated *Refund) Refund {
+	refund := Refund{Currency: calculated.Currency}
+
+	for _, item := range calculated.RefundLineItems {
+		refund.RefundLineItems = append(refund.RefundLineItems, RefundLineItem{
+			LineItemId:  item.LineItemId,
+			Quantity:    item.Quantity,
+			RestockType: item.RestockType,
+			LocationID:  item.LocationID,
+		})
+	}
+
+	if calculated.Shipping != nil && calculated.Shipping.Amount != nil && !calculated.Shipping.Amount.IsZero() {
+		refund.Shipping = &RefundShipping{Amount: calculated.Shipping.Amount}
+	}
+
+	for _, transaction := range calculated.Transactions {
+		refund.Transactions = append(refund.Transactions, Transaction{
+			ParentID: transaction.ParentID,
+			Amount:   transaction.Amount,
+			Kind:     TransactionKindRefund,
+			Gateway:  transaction.Gateway,
+		})
+	}
+
+	return refund
+}
+
+// List refunds of an order
+func (s *RefundServiceOp) List(orderID int64, options interface{}) ([]Refund, error) {
+	refunds, _, err := s.ListWithPagination(orderID, options)
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+// ListWithPagination lists refunds of an order and return pagination to retrieve next/previous results.
+func (s *RefundServiceOp) ListWithPagination(orderID int64, options interface{}) ([]Refund, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/refunds.json", ordersBasePath, orderID)
+	resource := new(RefundsResource)
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Refunds, pagination, nil
+}
+
+// Get individual refund
+func (s *RefundServiceOp) Get(orderID int64, refundID int64, options interface{}) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds/%d.json", ordersBasePath, orderID, refundID)
+	resource := new(RefundResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Refund, err
+}
+
+// Calculate a refund without creating it, returning the suggested transactions
+func (s *RefundServiceOp) Calculate(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds/calculate.json", ordersBasePath, orderID)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// Create a new refund
+func (s *RefundServiceOp) Create(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds.json", ordersBasePath, orderID)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
@@ -0,0 +1,253 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Amounts are rounded to cents when prorated
+const refundEstimatePlaces = 2
+
+// kind of the order adjustments recording refunded shipping
+const shippingRefundAdjustmentKind = "shipping_refund"
+
+// RefundEstimateOptions are the quantities and shipping to estimate a refund
+// for. Shipping is refunded in full or for Amount, priced like the order's
+// shipping lines, so including tax when the order has TaxesIncluded; a nil
+// Shipping refunds none. When Parent is set, the estimate includes a refund
+// transaction against it for the total.
+type RefundEstimateOptions struct {
+	Quantities  map[int64]int
+	RestockType string
+	LocationID  int64
+	Shipping    *RefundShipping
+	Parent      *Transaction
+}
+
+// RefundEstimateLine is the prorated refund of a line item
+type RefundEstimateLine struct {
+	LineItemID int64
+	Quantity   int
+	Subtotal   decimal.Decimal
+	Discount   decimal.Decimal
+	Tax        decimal.Decimal
+}
+
+// RefundEstimate is a refund estimated offline from an order. Subtotal is
+// after discounts. Tax includes the shipping tax and, when the order has
+// TaxesIncluded, is part of Subtotal and Shipping rather than added to Total.
+// Refund has the shape of a calculated refund and can be passed to
+// RefundService.Calculate or Create.
+type RefundEstimate struct {
+	Lines       []RefundEstimateLine
+	Subtotal    decimal.Decimal
+	Discount    decimal.Decimal
+	Tax         decimal.Decimal
+	Shipping    decimal.Decimal
+	ShippingTax decimal.Decimal
+	Total       decimal.Decimal
+	Refund      Refund
+}
+
+// EstimateRefund estimates the refund of the given quantities of the order's
+// line items without calling Shopify. Discounts and taxes of a line item are
+// prorated over its quantity. Quantities and shipping already refunded by the
+// order's Refunds can't be refunded again.
+func EstimateRefund(order *Order, options RefundEstimateOptions) (*RefundEstimate, error) {
+	estimate := &RefundEstimate{
+		Refund: Refund{OrderId: order.ID, Currency: order.Currency},
+	}
+
+	for id := range options.Quantities {
+		if findLineItem(order, id) == nil {
+			return nil, fmt.Errorf("line item %d not found in order %d", id, order.ID)
+		}
+	}
+
+	refunded := refundedQuantities(order)
+	for _, item := range order.LineItems {
+		quantity, ok := options.Quantities[item.ID]
+		if !ok {
+			continue
+		}
+
+		line, err := estimateRefundLine(item, quantity, refunded[item.ID])
+		if err != nil {
+			return nil, err
+		}
+		estimate.Lines = append(estimate.Lines, line)
+		estimate.Subtotal = estimate.Subtotal.Add(line.Subtotal)
+		estimate.Discount = estimate.Discount.Add(line.Discount)
+		estimate.Tax = estimate.Tax.Add(line.Tax)
+
+		estimate.Refund.RefundLineItems = append(estimate.Refund.RefundLineItems, RefundLineItem{
+			LineItemId:  item.ID,
+			Quantity:    quantity,
+			RestockType: options.RestockType,
+			LocationID:  options.LocationID,
+			Price:       item.Price,
+			Subtotal:    NewMoney(line.Subtotal, order.Currency),
+			TotalTax:    NewMoney(line.Tax, order.Currency),
+		})
+	}
+
+	if options.Shipping != nil {
+		amount, tax, err := estimateRefundShipping(order, options.Shipping)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Shipping = amount
+		estimate.ShippingTax = tax
+		estimate.Tax = estimate.Tax.Add(tax)
+		estimate.Refund.Shipping = &RefundShipping{Amount: NewMoney(amount, order.Currency), Tax: NewMoney(tax, order.Currency)}
+	}
+
+	estimate.Total = estimate.Subtotal.Add(estimate.Shipping)
+	if !order.TaxesIncluded {
+		estimate.Total = estimate.Total.Add(estimate.Tax)
+	}
+
+	if options.Parent != nil && estimate.Total.IsPositive() {
+		parentID := options.Parent.ID
+		estimate.Refund.Transactions = []Transaction{{
+			ParentID: &parentID,
+			Amount:   NewMoney(estimate.Total, ""),
+			Kind:     TransactionKindRefund,
+			Gateway:  options.Parent.Gateway,
+		}}
+	}
+
+	return estimate, nil
+}
+
+// EstimateCancellation estimates the refund of cancelling the order: every
+// line item not refunded yet is refunded and restocked with
+// RestockTypeCancel, along with the remaining shipping. The estimate's Refund
+// can be used as OrderCancelOptions.Refund.
+func EstimateCancellation(order *Order, parent *Transaction) (*RefundEstimate, error) {
+	refunded := refundedQuantities(order)
+	quantities := make(map[int64]int, len(order.LineItems))
+	for _, item := range order.LineItems {
+		if quantity := item.Quantity - refunded[item.ID]; quantity > 0 {
+			quantities[item.ID] = quantity
+		}
+	}
+
+	return EstimateRefund(order, RefundEstimateOptions{
+		Quantities:  quantities,
+		RestockType: RestockTypeCancel,
+		Shipping:    &RefundShipping{FullRefund: true},
+		Parent:      parent,
+	})
+}
+
+func estimateRefundLine(item LineItem, quantity int, refunded int) (RefundEstimateLine, error) {
+	if quantity <= 0 || quantity > item.Quantity-refunded {
+		return RefundEstimateLine{}, fmt.Errorf("cannot refund %d of line item %d with quantity %d, %d already refunded", quantity, item.ID, item.Quantity, refunded)
+	}
+
+	// Discount allocations include the line item's own discount, which is
+	// only used on its own by orders without allocations.
+	discount := decimal.Zero
+	if len(item.DiscountAllocations) > 0 {
+		for _, allocation := range item.DiscountAllocations {
+			discount = discount.Add(allocation.Amount.AmountOrZero())
+		}
+	} else {
+		discount = item.TotalDiscount.AmountOrZero()
+	}
+
+	tax := decimal.Zero
+	for _, taxLine := range item.TaxLines {
+		tax = tax.Add(prorateRemaining(taxLine.Price.AmountOrZero(), quantity, refunded, item.Quantity))
+	}
+
+	line := RefundEstimateLine{
+		LineItemID: item.ID,
+		Quantity:   quantity,
+		Discount:   prorateRemaining(discount, quantity, refunded, item.Quantity),
+		Tax:        tax,
+	}
+	line.Subtotal = item.Price.AmountOrZero().Mul(decimal.New(int64(quantity), 0)).Sub(line.Discount)
+	return line, nil
+}
+
+func estimateRefundShipping(order *Order, shipping *RefundShipping) (decimal.Decimal, decimal.Decimal, error) {
+	price, tax := decimal.Zero, decimal.Zero
+	for _, line := range order.ShippingLines {
+		price = price.Add(line.Price.AmountOrZero())
+		for _, taxLine := range line.TaxLines {
+			tax = tax.Add(taxLine.Price.AmountOrZero())
+		}
+	}
+
+	refundedPrice, refundedTax := refundedShipping(order)
+	if shipping.FullRefund {
+		return price.Sub(refundedPrice), tax.Sub(refundedTax), nil
+	}
+
+	amount := shipping.Amount.AmountOrZero()
+	if amount.IsNegative() || amount.GreaterThan(price.Sub(refundedPrice)) {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("cannot refund %s of shipping %s, %s already refunded", amount, price, refundedPrice)
+	}
+	if price.IsZero() {
+		return amount, decimal.Zero, nil
+	}
+	return amount, tax.Mul(amount).Div(price).Round(refundEstimatePlaces), nil
+}
+
+// prorate returns the part of amount for quantity out of total
+func prorate(amount decimal.Decimal, quantity, total int) decimal.Decimal {
+	if quantity == total {
+		return amount
+	}
+	return amount.Mul(decimal.New(int64(quantity), 0)).Div(decimal.New(int64(total), 0)).Round(refundEstimatePlaces)
+}
+
+// prorateRemaining returns the part of amount for quantity out of total when
+// refunded of total were already prorated. The last units get the remainder,
+// so that the parts add up to amount.
+func prorateRemaining(amount decimal.Decimal, quantity, refunded, total int) decimal.Decimal {
+	if refunded == 0 || quantity+refunded < total {
+		return prorate(amount, quantity, total)
+	}
+	return amount.Sub(prorate(amount, refunded, total))
+}
+
+// refundedQuantities returns the quantities of the order's line items
+// refunded by its refunds
+func refundedQuantities(order *Order) map[int64]int {
+	refunded := make(map[int64]int)
+	for _, refund := range order.Refunds {
+		for _, item := range refund.RefundLineItems {
+			refunded[item.LineItemId] += item.Quantity
+		}
+	}
+	return refunded
+}
+
+// refundedShipping returns the shipping and shipping tax refunded by the
+// order's refunds, recorded as negative order adjustments
+func refundedShipping(order *Order) (decimal.Decimal, decimal.Decimal) {
+	price, tax := decimal.Zero, decimal.Zero
+	for _, refund := range order.Refunds {
+		for _, adjustment := range refund.OrderAdjustments {
+			if adjustment.Kind != shippingRefundAdjustmentKind {
+				continue
+			}
+			price = price.Add(adjustment.Amount.AmountOrZero().Abs())
+			tax = tax.Add(adjustment.TaxAmount.AmountOrZero().Abs())
+		}
+	}
+	return price, tax
+}
+
+func findLineItem(order *Order, id int64) *LineItem {
+	for i := range order.LineItems {
+		if order.LineItems[i].ID == id {
+			return &order.LineItems[i]
+		}
+	}
+	return nil
+}
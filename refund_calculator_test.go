@@ -0,0 +1,220 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func refundEstimateOrder(taxesIncluded bool) *Order {
+	d := func(s string) *decimal.Decimal {
+		v := decimal.RequireFromString(s)
+		return &v
+	}
+	m := func(s string) *Money {
+		return NewMoney(decimal.RequireFromString(s), "")
+	}
+
+	return &Order{
+		ID:            450789469,
+		Currency:      "USD",
+		TaxesIncluded: taxesIncluded,
+		LineItems: []LineItem{
+			{
+				ID:                  466157049,
+				Quantity:            3,
+				Price:               m("10.00"),
+				TotalDiscount:       m("1.00"),
+				DiscountAllocations: []DiscountAllocations{{Amount: m("1.00")}, {Amount: m("2.00")}},
+				TaxLines:            []TaxLine{{Title: "State Tax", Price: m("2.10"), Rate: d("0.1")}},
+			},
+			{
+				ID:            518995019,
+				Quantity:      1,
+				Price:         m("5.00"),
+				TotalDiscount: m("0.50"),
+				TaxLines:      []TaxLine{{Title: "State Tax", Price: m("0.45"), Rate: d("0.1")}},
+			},
+		},
+		ShippingLines: []ShippingLines{
+			{ID: 369256396, Price: m("10.00"), TaxLines: []TaxLine{{Title: "State Tax", Price: m("1.00"), Rate: d("0.1")}}},
+		},
+	}
+}
+
+func refundEstimateTests(t *testing.T, estimate *RefundEstimate, expected map[string]string) {
+	actual := map[string]decimal.Decimal{
+		"subtotal": estimate.Subtotal,
+		"discount": estimate.Discount,
+		"tax":      estimate.Tax,
+		"shipping": estimate.Shipping,
+		"total":    estimate.Total,
+	}
+	for key, value := range expected {
+		if !actual[key].Equal(decimal.RequireFromString(value)) {
+			t.Errorf("RefundEstimate %s is %s, expected %s", key, actual[key], value)
+		}
+	}
+}
+
+func TestEstimateRefund(t *testing.T) {
+	amount := NewMoney(decimal.RequireFromString("5.00"), "")
+	estimate, err := EstimateRefund(refundEstimateOrder(false), RefundEstimateOptions{
+		Quantities:  map[int64]int{466157049: 2, 518995019: 1},
+		RestockType: RestockTypeReturn,
+		LocationID:  487838322,
+		Shipping:    &RefundShipping{Amount: amount},
+		Parent:      &Transaction{ID: 389404469, Gateway: "bogus"},
+	})
+	if err != nil {
+		t.Fatalf("EstimateRefund returned error: %v", err)
+	}
+
+	refundEstimateTests(t, estimate, map[string]string{
+		"subtotal": "22.50",
+		"discount": "2.50",
+		"tax":      "2.35",
+		"shipping": "5.00",
+		"total":    "29.85",
+	})
+
+	expectedLines := []struct {
+		subtotal, discount, tax string
+	}{
+		{"18.00", "2.00", "1.40"},
+		{"4.50", "0.50", "0.45"},
+	}
+	if len(estimate.Lines) != len(expectedLines) {
+		t.Fatalf("EstimateRefund returned lines %+v", estimate.Lines)
+	}
+	for i, expected := range expectedLines {
+		line := estimate.Lines[i]
+		if !line.Subtotal.Equal(decimal.RequireFromString(expected.subtotal)) ||
+			!line.Discount.Equal(decimal.RequireFromString(expected.discount)) ||
+			!line.Tax.Equal(decimal.RequireFromString(expected.tax)) {
+			t.Errorf("EstimateRefund returned line %+v, expected %+v", line, expected)
+		}
+	}
+
+	body, _ := json.Marshal(RefundResource{Refund: &estimate.Refund})
+	expectedBody := `{"refund":{"order_id":450789469,"currency":"USD","shipping":{"amount":"5","tax":"0.5"},` +
+		`"refund_line_items":[{"quantity":2,"line_item_id":466157049,"restock_type":"return","location_id":487838322,"price":"10","subtotal":"18","total_tax":"1.4"},` +
+		`{"quantity":1,"line_item_id":518995019,"restock_type":"return","location_id":487838322,"price":"5","subtotal":"4.5","total_tax":"0.45"}],` +
+		`"transactions":[{"amount":"29.85","kind":"refund","gateway":"bogus","parent_id":389404469}]}}`
+	if string(body) != expectedBody {
+		t.Errorf("EstimateRefund returned refund %s, expected %s", body, expectedBody)
+	}
+}
+
+func TestEstimateRefundTaxesIncluded(t *testing.T) {
+	amount := NewMoney(decimal.RequireFromString("5.00"), "")
+	estimate, err := EstimateRefund(refundEstimateOrder(true), RefundEstimateOptions{
+		Quantities: map[int64]int{466157049: 2, 518995019: 1},
+		Shipping:   &RefundShipping{Amount: amount},
+	})
+	if err != nil {
+		t.Fatalf("EstimateRefund returned error: %v", err)
+	}
+
+	refundEstimateTests(t, estimate, map[string]string{
+		"subtotal": "22.50",
+		"tax":      "2.35",
+		"shipping": "5.00",
+		"total":    "27.50",
+	})
+
+	if len(estimate.Refund.Transactions) != 0 {
+		t.Errorf("EstimateRefund returned transactions %+v without a parent", estimate.Refund.Transactions)
+	}
+}
+
+func TestEstimateCancellation(t *testing.T) {
+	estimate, err := EstimateCancellation(refundEstimateOrder(false), nil)
+	if err != nil {
+		t.Fatalf("EstimateCancellation returned error: %v", err)
+	}
+
+	refundEstimateTests(t, estimate, map[string]string{
+		"subtotal": "31.50",
+		"discount": "3.50",
+		"tax":      "3.55",
+		"shipping": "10.00",
+		"total":    "45.05",
+	})
+
+	for _, item := range estimate.Refund.RefundLineItems {
+		if item.RestockType != RestockTypeCancel {
+			t.Errorf("EstimateCancellation returned line item %+v, expected restock type %s", item, RestockTypeCancel)
+		}
+	}
+}
+
+func TestEstimateRefundErrors(t *testing.T) {
+	tooMuch := NewMoney(decimal.RequireFromString("10.01"), "")
+
+	cases := []struct {
+		description string
+		options     RefundEstimateOptions
+	}{
+		{"unknown line item", RefundEstimateOptions{Quantities: map[int64]int{1: 1}}},
+		{"quantity too high", RefundEstimateOptions{Quantities: map[int64]int{466157049: 4}}},
+		{"quantity zero", RefundEstimateOptions{Quantities: map[int64]int{466157049: 0}}},
+		{"shipping too high", RefundEstimateOptions{Shipping: &RefundShipping{Amount: tooMuch}}},
+	}
+
+	for _, c := range cases {
+		if _, err := EstimateRefund(refundEstimateOrder(false), c.options); err == nil {
+			t.Errorf("EstimateRefund (%s) returned no error", c.description)
+		}
+	}
+}
+
+// refundEstimatePartlyRefundedOrder is refundEstimateOrder after refunding one
+// of the first line item and 4.00 of shipping
+func refundEstimatePartlyRefundedOrder() *Order {
+	order := refundEstimateOrder(false)
+	shipping, shippingTax := NewMoney(decimal.RequireFromString("-4.00"), ""), NewMoney(decimal.RequireFromString("-0.40"), "")
+	order.Refunds = []Refund{{
+		RefundLineItems:  []RefundLineItem{{LineItemId: 466157049, Quantity: 1}},
+		OrderAdjustments: []OrderAdjustment{{Kind: "shipping_refund", Amount: shipping, TaxAmount: shippingTax}},
+	}}
+	return order
+}
+
+func TestEstimateCancellationPartlyRefunded(t *testing.T) {
+	estimate, err := EstimateCancellation(refundEstimatePartlyRefundedOrder(), nil)
+	if err != nil {
+		t.Fatalf("EstimateCancellation returned error: %v", err)
+	}
+
+	refundEstimateTests(t, estimate, map[string]string{
+		"subtotal": "22.50",
+		"discount": "2.50",
+		"tax":      "2.45",
+		"shipping": "6.00",
+		"total":    "30.95",
+	})
+
+	if len(estimate.Lines) != 2 || estimate.Lines[0].Quantity != 2 || !estimate.Lines[0].Tax.Equal(decimal.RequireFromString("1.40")) {
+		t.Errorf("EstimateCancellation returned lines %+v", estimate.Lines)
+	}
+}
+
+func TestEstimateRefundPartlyRefundedErrors(t *testing.T) {
+	tooMuch := NewMoney(decimal.RequireFromString("6.01"), "")
+
+	cases := []struct {
+		description string
+		options     RefundEstimateOptions
+	}{
+		{"quantity already refunded", RefundEstimateOptions{Quantities: map[int64]int{466157049: 3}}},
+		{"shipping already refunded", RefundEstimateOptions{Shipping: &RefundShipping{Amount: tooMuch}}},
+	}
+
+	for _, c := range cases {
+		if _, err := EstimateRefund(refundEstimatePartlyRefundedOrder(), c.options); err == nil {
+			t.Errorf("EstimateRefund (%s) returned no error", c.description)
+		}
+	}
+}
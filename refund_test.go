@@ -0,0 +1,180 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func refundTests(t *testing.T, refund *Refund) {
+	if refund == nil {
+		t.Fatal("Refund is nil")
+	}
+
+	if refund.Id != 929361463 || refund.OrderId != 450789469 || refund.Note != "wrong size" {
+		t.Errorf("Refund returned %+v", refund)
+	}
+
+	if len(refund.RefundLineItems) != 1 {
+		t.Fatalf("Refund.RefundLineItems returned %+v, expected 1 item", refund.RefundLineItems)
+	}
+	item := refund.RefundLineItems[0]
+	if item.RestockType != RestockTypeReturn || item.LocationID != 487838322 || item.LineItem == nil || item.LineItem.ID != 703073504 {
+		t.Errorf("Refund.RefundLineItems[0] returned %+v", item)
+	}
+	if item.Subtotal == nil || !item.Subtotal.Equal(decimal.RequireFromString("195.66")) {
+		t.Errorf("Refund.RefundLineItems[0].Subtotal returned %v, expected 195.66", item.Subtotal)
+	}
+
+	if len(refund.Transactions) != 1 || refund.Transactions[0].Kind != TransactionKindRefund ||
+		!refund.Transactions[0].Amount.Equal(decimal.RequireFromString("41.94")) {
+		t.Errorf("Refund.Transactions returned %+v", refund.Transactions)
+	}
+
+	if len(refund.OrderAdjustments) != 1 || refund.OrderAdjustments[0].Kind != "shipping_refund" ||
+		!refund.OrderAdjustments[0].Amount.Equal(decimal.RequireFromString("-5.00")) {
+		t.Errorf("Refund.OrderAdjustments returned %+v", refund.OrderAdjustments)
+	}
+}
+
+func TestRefundList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refunds.json")))
+
+	refunds, err := client.Refund.List(450789469, nil)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	if len(refunds) != 1 {
+		t.Fatalf("Refund.List returned %d refunds, expected 1", len(refunds))
+	}
+	refundTests(t, &refunds[0])
+}
+
+func TestRefundListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"refunds": [{"id":1},{"id":2}]}`)
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	refunds, pagination, err := client.Refund.ListWithPagination(450789469, ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Refund.ListWithPagination returned error: %v", err)
+	}
+
+	expectedRefunds := []Refund{{Id: 1}, {Id: 2}}
+	if !reflect.DeepEqual(refunds, expectedRefunds) {
+		t.Errorf("Refund.ListWithPagination returned %+v, expected %+v", refunds, expectedRefunds)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "foo", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Refund.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/929361463.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund.json")))
+
+	refund, err := client.Refund.Get(450789469, 929361463, nil)
+	if err != nil {
+		t.Errorf("Refund.Get returned error: %v", err)
+	}
+
+	refundTests(t, refund)
+}
+
+func TestRefundCalculateThenCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calculateBody, createBody string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/calculate.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			calculateBody = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("refund_calculate.json")), nil
+		})
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			createBody = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("refund.json")), nil
+		})
+
+	calculated, err := client.Refund.Calculate(450789469, Refund{
+		Shipping: &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 518995019, Quantity: 1, RestockType: RestockTypeReturn, LocationID: 487838322},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Refund.Calculate returned error: %v", err)
+	}
+
+	expectedCalculateBody := `{"refund":{"shipping":{"full_refund":true},"refund_line_items":[{"quantity":1,"line_item_id":518995019,"restock_type":"return","location_id":487838322}]}}`
+	if calculateBody != expectedCalculateBody {
+		t.Errorf("Refund.Calculate sent %s, expected %s", calculateBody, expectedCalculateBody)
+	}
+
+	if len(calculated.Transactions) != 1 || calculated.Transactions[0].Kind != TransactionKindSuggestedRefund ||
+		!calculated.Transactions[0].MaximumRefundable.Equal(decimal.RequireFromString("204.65")) {
+		t.Errorf("Refund.Calculate returned transactions %+v", calculated.Transactions)
+	}
+	if calculated.Shipping == nil || !calculated.Shipping.MaximumRefundable.Equal(decimal.RequireFromString("5.00")) {
+		t.Errorf("Refund.Calculate returned shipping %+v", calculated.Shipping)
+	}
+
+	refund := NewRefundFromCalculation(calculated)
+	refund.Notify = true
+	refund.Note = "wrong size"
+
+	created, err := client.Refund.Create(450789469, refund)
+	if err != nil {
+		t.Fatalf("Refund.Create returned error: %v", err)
+	}
+
+	var sent struct {
+		Refund map[string]json.RawMessage `json:"refund"`
+	}
+	json.Unmarshal([]byte(createBody), &sent)
+	expectedCreate := map[string]string{
+		"note":              `"wrong size"`,
+		"notify":            `true`,
+		"currency":          `"USD"`,
+		"shipping":          `{"amount":"5"}`,
+		"refund_line_items": `[{"quantity":1,"line_item_id":518995019,"restock_type":"return","location_id":487838322}]`,
+		"transactions":      `[{"amount":"204.65","kind":"refund","gateway":"bogus","parent_id":389404469}]`,
+	}
+	if len(sent.Refund) != len(expectedCreate) {
+		t.Errorf("Refund.Create sent %s", createBody)
+	}
+	for key, expected := range expectedCreate {
+		if string(sent.Refund[key]) != expected {
+			t.Errorf("Refund.Create sent %s %s, expected %s", key, sent.Refund[key], expected)
+		}
+	}
+
+	refundTests(t, created)
+}
@@ -0,0 +1,526 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ReturnStatus is the status of a return
+type ReturnStatus string
+
+// Statuses of a return. Requested returns are approved to open or declined,
+// open returns are closed once processed and closed returns can be reopened.
+const (
+	ReturnStatusRequested ReturnStatus = "REQUESTED"
+	ReturnStatusOpen      ReturnStatus = "OPEN"
+	ReturnStatusClosed    ReturnStatus = "CLOSED"
+	ReturnStatusDeclined  ReturnStatus = "DECLINED"
+	ReturnStatusCanceled  ReturnStatus = "CANCELED"
+)
+
+// ReturnReason is the reason a customer returns an item
+type ReturnReason string
+
+// Reasons for returning an item
+const (
+	ReturnReasonColor          ReturnReason = "COLOR"
+	ReturnReasonDefective      ReturnReason = "DEFECTIVE"
+	ReturnReasonNotAsDescribed ReturnReason = "NOT_AS_DESCRIBED"
+	ReturnReasonSizeTooLarge   ReturnReason = "SIZE_TOO_LARGE"
+	ReturnReasonSizeTooSmall   ReturnReason = "SIZE_TOO_SMALL"
+	ReturnReasonStyle          ReturnReason = "STYLE"
+	ReturnReasonUnwanted       ReturnReason = "UNWANTED"
+	ReturnReasonWrongItem      ReturnReason = "WRONG_ITEM"
+	ReturnReasonOther          ReturnReason = "OTHER"
+	ReturnReasonUnknown        ReturnReason = "UNKNOWN"
+)
+
+// ReturnDeclineReason is the reason a return request is declined
+type ReturnDeclineReason string
+
+// Reasons for declining a return request
+const (
+	ReturnDeclineReasonReturnPeriodEnded ReturnDeclineReason = "RETURN_PERIOD_ENDED"
+	ReturnDeclineReasonFinalSale         ReturnDeclineReason = "FINAL_SALE"
+	ReturnDeclineReasonOther             ReturnDeclineReason = "OTHER"
+)
+
+// ReverseFulfillmentOrderStatus is the status of a reverse fulfillment order
+type ReverseFulfillmentOrderStatus string
+
+// Statuses of a reverse fulfillment order
+const (
+	ReverseFulfillmentOrderStatusOpen     ReverseFulfillmentOrderStatus = "OPEN"
+	ReverseFulfillmentOrderStatusClosed   ReverseFulfillmentOrderStatus = "CLOSED"
+	ReverseFulfillmentOrderStatusCanceled ReverseFulfillmentOrderStatus = "CANCELED"
+)
+
+// returnTransitions are the statuses a return can move to from each status
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested: {ReturnStatusOpen, ReturnStatusDeclined, ReturnStatusCanceled},
+	ReturnStatusOpen:      {ReturnStatusClosed, ReturnStatusCanceled},
+	ReturnStatusClosed:    {ReturnStatusOpen},
+}
+
+// CanTransitionTo returns whether a return with the status can move to status
+// to
+func (s ReturnStatus) CanTransitionTo(to ReturnStatus) bool {
+	for _, status := range returnTransitions[s] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ReturnService is an interface for interfacing with the returns of the
+// Admin GraphQL API. Returns, their line items and reverse fulfillment orders
+// are identified by GraphQL global IDs, orders by their REST IDs. Requests
+// Shopify rejects return a GraphQLMutationError.
+// See: https://shopify.dev/docs/apps/fulfillment/returns-apps
+type ReturnService interface {
+	Request(ReturnRequest) (*Return, error)
+	Get(string) (*Return, error)
+	ListByOrder(int64) ([]Return, error)
+	Approve(string) (*Return, error)
+	Decline(string, ReturnDeclineReason, string, bool) (*Return, error)
+	Close(string) (*Return, error)
+	Reopen(string) (*Return, error)
+	ListReturnableFulfillments(int64) ([]ReturnableFulfillment, error)
+	GetReverseFulfillmentOrder(string) (*ReverseFulfillmentOrder, error)
+}
+
+// ReturnServiceOp handles communication with the return related queries and
+// mutations of the Shopify API.
+type ReturnServiceOp struct {
+	client *Client
+}
+
+// Return represents a Shopify return. Order only has its ID and name set.
+type Return struct {
+	ID                       string                    `json:"id"`
+	Name                     string                    `json:"name"`
+	Status                   ReturnStatus              `json:"status"`
+	Order                    *Order                    `json:"order"`
+	Decline                  *ReturnDecline            `json:"decline"`
+	ReturnLineItems          []ReturnLineItem          `json:"return_line_items"`
+	ReverseFulfillmentOrders []ReverseFulfillmentOrder `json:"reverse_fulfillment_orders"`
+}
+
+// ReturnDecline is why a return request was declined
+type ReturnDecline struct {
+	Reason ReturnDeclineReason `json:"reason"`
+	Note   string              `json:"note"`
+}
+
+// ReturnLineItem is a fulfilled line item being returned
+type ReturnLineItem struct {
+	ID                  string               `json:"id"`
+	Quantity            int                  `json:"quantity"`
+	ReturnReason        ReturnReason         `json:"return_reason"`
+	ReturnReasonNote    string               `json:"return_reason_note"`
+	CustomerNote        string               `json:"customer_note"`
+	FulfillmentLineItem *FulfillmentLineItem `json:"fulfillment_line_item"`
+}
+
+// FulfillmentLineItem is a line item of a fulfillment. LineItem only has its
+// ID, name, title, SKU and quantity set.
+type FulfillmentLineItem struct {
+	ID       string    `json:"id"`
+	Quantity int       `json:"quantity"`
+	LineItem *LineItem `json:"line_item"`
+}
+
+// ReturnableFulfillment is a fulfillment with line items that can be
+// returned. Fulfillment only has its ID and status set.
+type ReturnableFulfillment struct {
+	ID          string                          `json:"id"`
+	Fulfillment *Fulfillment                    `json:"fulfillment"`
+	LineItems   []ReturnableFulfillmentLineItem `json:"line_items"`
+}
+
+// ReturnableFulfillmentLineItem is a fulfillment line item and the quantity
+// of it that can still be returned
+type ReturnableFulfillmentLineItem struct {
+	Quantity            int                  `json:"quantity"`
+	FulfillmentLineItem *FulfillmentLineItem `json:"fulfillment_line_item"`
+}
+
+// ReverseFulfillmentOrder groups the line items of a return to receive back
+// at a location
+type ReverseFulfillmentOrder struct {
+	ID        string                            `json:"id"`
+	Status    ReverseFulfillmentOrderStatus     `json:"status"`
+	LineItems []ReverseFulfillmentOrderLineItem `json:"line_items"`
+}
+
+// ReverseFulfillmentOrderLineItem is a line item of a reverse fulfillment
+// order
+type ReverseFulfillmentOrderLineItem struct {
+	ID                  string               `json:"id"`
+	TotalQuantity       int                  `json:"total_quantity"`
+	FulfillmentLineItem *FulfillmentLineItem `json:"fulfillment_line_item"`
+}
+
+// ReturnRequest is a customer's request to return fulfilled line items of an
+// order
+type ReturnRequest struct {
+	OrderID   int64
+	LineItems []ReturnRequestLineItem
+}
+
+// ReturnRequestLineItem is a quantity of a fulfillment line item to return
+type ReturnRequestLineItem struct {
+	FulfillmentLineItemID string       `json:"fulfillmentLineItemId"`
+	Quantity              int          `json:"quantity"`
+	ReturnReason          ReturnReason `json:"returnReason"`
+	CustomerNote          string       `json:"customerNote,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (r *Return) UnmarshalJSON(data []byte) error {
+	type alias Return
+	resource := struct {
+		*alias
+		Order *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"order"`
+		ReturnLineItems          json.RawMessage `json:"return_line_items"`
+		ReverseFulfillmentOrders json.RawMessage `json:"reverse_fulfillment_orders"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return err
+	}
+
+	if resource.Order != nil {
+		id, err := legacyResourceID(resource.Order.ID)
+		if err != nil {
+			return err
+		}
+		r.Order = &Order{ID: id, Name: resource.Order.Name}
+	}
+	if len(resource.ReturnLineItems) > 0 {
+		if err := unmarshalConnection(resource.ReturnLineItems, &r.ReturnLineItems); err != nil {
+			return err
+		}
+	}
+	if len(resource.ReverseFulfillmentOrders) > 0 {
+		return unmarshalConnection(resource.ReverseFulfillmentOrders, &r.ReverseFulfillmentOrders)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (i *FulfillmentLineItem) UnmarshalJSON(data []byte) error {
+	type alias FulfillmentLineItem
+	resource := struct {
+		*alias
+		LineItem *struct {
+			ID       string `json:"id"`
+			Name     string `json:"name"`
+			Title    string `json:"title"`
+			SKU      string `json:"sku"`
+			Quantity int    `json:"quantity"`
+		} `json:"line_item"`
+	}{alias: (*alias)(i)}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return err
+	}
+
+	if resource.LineItem != nil {
+		id, err := legacyResourceID(resource.LineItem.ID)
+		if err != nil {
+			return err
+		}
+		i.LineItem = &LineItem{
+			ID:       id,
+			Name:     resource.LineItem.Name,
+			Title:    resource.LineItem.Title,
+			SKU:      resource.LineItem.SKU,
+			Quantity: resource.LineItem.Quantity,
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *ReturnableFulfillment) UnmarshalJSON(data []byte) error {
+	type alias ReturnableFulfillment
+	resource := struct {
+		*alias
+		Fulfillment *struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"fulfillment"`
+		LineItems json.RawMessage `json:"line_items"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return err
+	}
+
+	if resource.Fulfillment != nil {
+		id, err := legacyResourceID(resource.Fulfillment.ID)
+		if err != nil {
+			return err
+		}
+		f.Fulfillment = &Fulfillment{ID: id, Status: strings.ToLower(resource.Fulfillment.Status)}
+	}
+	if len(resource.LineItems) > 0 {
+		return unmarshalConnection(resource.LineItems, &f.LineItems)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *ReverseFulfillmentOrder) UnmarshalJSON(data []byte) error {
+	type alias ReverseFulfillmentOrder
+	resource := struct {
+		*alias
+		LineItems json.RawMessage `json:"line_items"`
+	}{alias: (*alias)(o)}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return err
+	}
+
+	if len(resource.LineItems) > 0 {
+		return unmarshalConnection(resource.LineItems, &o.LineItems)
+	}
+	return nil
+}
+
+const returnFulfillmentLineItemFragment = `
+fragment fulfillmentLineItem on FulfillmentLineItem {
+  id
+  quantity
+  line_item: lineItem { id name title sku quantity }
+}`
+
+const returnReverseFulfillmentOrderFragment = `
+fragment reverseFulfillmentOrder on ReverseFulfillmentOrder {
+  id
+  status
+  line_items: lineItems(first: 250) {
+    edges { node { id total_quantity: totalQuantity fulfillment_line_item: fulfillmentLineItem { ...fulfillmentLineItem } } }
+  }
+}`
+
+const returnFragment = `
+fragment return on Return {
+  id
+  name
+  status
+  order { id name }
+  decline { reason note }
+  return_line_items: returnLineItems(first: 250) {
+    edges {
+      node {
+        id
+        quantity
+        return_reason: returnReason
+        return_reason_note: returnReasonNote
+        customer_note: customerNote
+        fulfillment_line_item: fulfillmentLineItem { ...fulfillmentLineItem }
+      }
+    }
+  }
+  reverse_fulfillment_orders: reverseFulfillmentOrders(first: 50) { edges { node { ...reverseFulfillmentOrder } } }
+}` + returnReverseFulfillmentOrderFragment + returnFulfillmentLineItemFragment
+
+// returnMutation runs a return mutation returning the return, and returns its
+// user errors as a GraphQLMutationError.
+func (s *ReturnServiceOp) returnMutation(mutation, arguments, parameters string, variables interface{}) (*Return, error) {
+	query := fmt.Sprintf(`mutation %[1]s(%[2]s) {
+  result: %[1]s(%[3]s) {
+    return { ...return }
+    user_errors: userErrors { field message code }
+  }
+}`, mutation, arguments, parameters) + returnFragment
+
+	resource := struct {
+		Result struct {
+			Return     *Return            `json:"return"`
+			UserErrors []GraphQLUserError `json:"user_errors"`
+		} `json:"result"`
+	}{}
+	err := s.client.graphQL(query, variables, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resource.Result.UserErrors) > 0 {
+		return nil, GraphQLMutationError{Mutation: mutation, UserErrors: resource.Result.UserErrors}
+	}
+	return resource.Result.Return, nil
+}
+
+// returnIDVariables are the variables of mutations on a single return
+type returnIDVariables struct {
+	ID string `json:"id"`
+}
+
+type returnPageVariables struct {
+	ID    string `json:"id"`
+	After string `json:"after,omitempty"`
+}
+
+// Request a return of fulfilled line items of an order. The return is
+// requested until approved or declined.
+func (s *ReturnServiceOp) Request(request ReturnRequest) (*Return, error) {
+	variables := struct {
+		Input struct {
+			OrderID         string                  `json:"orderId"`
+			ReturnLineItems []ReturnRequestLineItem `json:"returnLineItems"`
+		} `json:"input"`
+	}{}
+	variables.Input.OrderID = graphQLID("Order", request.OrderID)
+	variables.Input.ReturnLineItems = request.LineItems
+
+	return s.returnMutation("returnRequest", "$input: ReturnRequestInput!", "input: $input", variables)
+}
+
+// Get individual return
+func (s *ReturnServiceOp) Get(returnID string) (*Return, error) {
+	query := `query return($id: ID!) {
+  return(id: $id) { ...return }
+}` + returnFragment
+
+	resource := struct {
+		Return *Return `json:"return"`
+	}{}
+	err := s.client.graphQL(query, returnIDVariables{returnID}, &resource)
+	return resource.Return, err
+}
+
+// ListByOrder lists the returns of an order, fetching them page by page
+func (s *ReturnServiceOp) ListByOrder(orderID int64) ([]Return, error) {
+	query := `query orderReturns($id: ID!, $after: String) {
+  order(id: $id) {
+    returns(first: 50, after: $after) {
+      edges { node { ...return } }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}` + returnFragment
+
+	variables := returnPageVariables{ID: graphQLID("Order", orderID)}
+	var returns []Return
+	for {
+		resource := struct {
+			Order *struct {
+				Returns json.RawMessage `json:"returns"`
+			} `json:"order"`
+		}{}
+		err := s.client.graphQL(query, variables, &resource)
+		if err != nil || resource.Order == nil {
+			return returns, err
+		}
+
+		var page []Return
+		pageInfo, err := unmarshalConnectionPage(resource.Order.Returns, &page)
+		if err != nil {
+			return nil, err
+		}
+		returns = append(returns, page...)
+		if !pageInfo.HasNextPage {
+			return returns, nil
+		}
+		variables.After = pageInfo.EndCursor
+	}
+}
+
+// Approve a requested return, opening it
+func (s *ReturnServiceOp) Approve(returnID string) (*Return, error) {
+	variables := struct {
+		Input returnIDVariables `json:"input"`
+	}{returnIDVariables{returnID}}
+	return s.returnMutation("returnApproveRequest", "$input: ReturnApproveRequestInput!", "input: $input", variables)
+}
+
+// Decline a requested return for a reason, with a note shown to the customer
+// if notifyCustomer is set
+func (s *ReturnServiceOp) Decline(returnID string, reason ReturnDeclineReason, note string, notifyCustomer bool) (*Return, error) {
+	variables := struct {
+		Input struct {
+			ID             string              `json:"id"`
+			DeclineReason  ReturnDeclineReason `json:"declineReason"`
+			DeclineNote    string              `json:"declineNote,omitempty"`
+			NotifyCustomer bool                `json:"notifyCustomer"`
+		} `json:"input"`
+	}{}
+	variables.Input.ID = returnID
+	variables.Input.DeclineReason = reason
+	variables.Input.DeclineNote = note
+	variables.Input.NotifyCustomer = notifyCustomer
+
+	return s.returnMutation("returnDeclineRequest", "$input: ReturnDeclineRequestInput!", "input: $input", variables)
+}
+
+// Close an open return once it has been processed
+func (s *ReturnServiceOp) Close(returnID string) (*Return, error) {
+	return s.returnMutation("returnClose", "$id: ID!", "id: $id", returnIDVariables{returnID})
+}
+
+// Reopen a closed return
+func (s *ReturnServiceOp) Reopen(returnID string) (*Return, error) {
+	return s.returnMutation("returnReopen", "$id: ID!", "id: $id", returnIDVariables{returnID})
+}
+
+// ListReturnableFulfillments lists the fulfillments of an order with line
+// items that can be returned, fetching them page by page
+func (s *ReturnServiceOp) ListReturnableFulfillments(orderID int64) ([]ReturnableFulfillment, error) {
+	query := `query returnableFulfillments($orderId: ID!, $after: String) {
+  returnableFulfillments(orderId: $orderId, first: 50, after: $after) {
+    edges {
+      node {
+        id
+        fulfillment { id status }
+        line_items: returnableFulfillmentLineItems(first: 250) {
+          edges { node { quantity fulfillment_line_item: fulfillmentLineItem { ...fulfillmentLineItem } } }
+        }
+      }
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}` + returnFulfillmentLineItemFragment
+
+	variables := struct {
+		OrderID string `json:"orderId"`
+		After   string `json:"after,omitempty"`
+	}{OrderID: graphQLID("Order", orderID)}
+	var fulfillments []ReturnableFulfillment
+	for {
+		resource := struct {
+			ReturnableFulfillments json.RawMessage `json:"returnableFulfillments"`
+		}{}
+		err := s.client.graphQL(query, variables, &resource)
+		if err != nil || len(resource.ReturnableFulfillments) == 0 {
+			return fulfillments, err
+		}
+
+		var page []ReturnableFulfillment
+		pageInfo, err := unmarshalConnectionPage(resource.ReturnableFulfillments, &page)
+		if err != nil {
+			return nil, err
+		}
+		fulfillments = append(fulfillments, page...)
+		if !pageInfo.HasNextPage {
+			return fulfillments, nil
+		}
+		variables.After = pageInfo.EndCursor
+	}
+}
+
+// GetReverseFulfillmentOrder gets a reverse fulfillment order of a return
+func (s *ReturnServiceOp) GetReverseFulfillmentOrder(reverseFulfillmentOrderID string) (*ReverseFulfillmentOrder, error) {
+	query := `query reverseFulfillmentOrder($id: ID!) {
+  reverseFulfillmentOrder(id: $id) { ...reverseFulfillmentOrder }
+}` + returnReverseFulfillmentOrderFragment + returnFulfillmentLineItemFragment
+
+	resource := struct {
+		ReverseFulfillmentOrder *ReverseFulfillmentOrder `json:"reverseFulfillmentOrder"`
+	}{}
+	err := s.client.graphQL(query, returnIDVariables{reverseFulfillmentOrderID}, &resource)
+	return resource.ReverseFulfillmentOrder, err
+}
@@ -0,0 +1,330 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func checkReturnRequest(t *testing.T, request graphQLRequest, operation string, expectedVariables map[string]interface{}) {
+	if !strings.HasPrefix(request.Query, operation+"(") {
+		t.Errorf("Return sent query %s, expected %s", request.Query, operation)
+	}
+	if !reflect.DeepEqual(request.Variables, expectedVariables) {
+		t.Errorf("Return sent variables %+v, expected %+v", request.Variables, expectedVariables)
+	}
+}
+
+func fulfillmentLineItemTests(t *testing.T, item *FulfillmentLineItem) {
+	expected := &FulfillmentLineItem{
+		ID:       "gid://shopify/FulfillmentLineItem/1031",
+		Quantity: 1,
+		LineItem: &LineItem{
+			ID:       466157049,
+			Name:     "IPod Nano - 8gb - green",
+			Title:    "IPod Nano - 8gb",
+			SKU:      "IPOD2008GREEN",
+			Quantity: 1,
+		},
+	}
+	if !reflect.DeepEqual(item, expected) {
+		t.Errorf("FulfillmentLineItem returned %+v, expected %+v", item, expected)
+	}
+}
+
+func reverseFulfillmentOrderTests(t *testing.T, order *ReverseFulfillmentOrder) {
+	if order == nil {
+		t.Fatal("ReverseFulfillmentOrder is nil")
+	}
+	if order.ID != "gid://shopify/ReverseFulfillmentOrder/71" || order.Status != ReverseFulfillmentOrderStatusOpen {
+		t.Errorf("ReverseFulfillmentOrder returned %+v", order)
+	}
+	if len(order.LineItems) != 1 || order.LineItems[0].TotalQuantity != 1 {
+		t.Fatalf("ReverseFulfillmentOrder.LineItems returned %+v", order.LineItems)
+	}
+	fulfillmentLineItemTests(t, order.LineItems[0].FulfillmentLineItem)
+}
+
+func returnTests(t *testing.T, r *Return) {
+	if r == nil {
+		t.Fatal("Return is nil")
+	}
+	if r.ID != "gid://shopify/Return/945000954" || r.Name != "#1001-R1" || r.Status != ReturnStatusOpen || r.Decline != nil {
+		t.Errorf("Return returned %+v", r)
+	}
+
+	expectedOrder := &Order{ID: 450789469, Name: "#1001"}
+	if !reflect.DeepEqual(r.Order, expectedOrder) {
+		t.Errorf("Return.Order returned %+v, expected %+v", r.Order, expectedOrder)
+	}
+
+	if len(r.ReturnLineItems) != 1 {
+		t.Fatalf("Return.ReturnLineItems returned %+v", r.ReturnLineItems)
+	}
+	item := r.ReturnLineItems[0]
+	if item.ID != "gid://shopify/ReturnLineItem/677614677" || item.Quantity != 1 ||
+		item.ReturnReason != ReturnReasonSizeTooSmall || item.CustomerNote != "Too tight" {
+		t.Errorf("Return.ReturnLineItems[0] returned %+v", item)
+	}
+	fulfillmentLineItemTests(t, item.FulfillmentLineItem)
+
+	if len(r.ReverseFulfillmentOrders) != 1 {
+		t.Fatalf("Return.ReverseFulfillmentOrders returned %+v", r.ReverseFulfillmentOrders)
+	}
+	reverseFulfillmentOrderTests(t, &r.ReverseFulfillmentOrders[0])
+}
+
+func TestReturnStatusCanTransitionTo(t *testing.T) {
+	cases := []struct {
+		from     ReturnStatus
+		to       ReturnStatus
+		expected bool
+	}{
+		{ReturnStatusRequested, ReturnStatusOpen, true},
+		{ReturnStatusRequested, ReturnStatusDeclined, true},
+		{ReturnStatusOpen, ReturnStatusClosed, true},
+		{ReturnStatusClosed, ReturnStatusOpen, true},
+		{ReturnStatusOpen, ReturnStatusDeclined, false},
+		{ReturnStatusDeclined, ReturnStatusOpen, false},
+		{ReturnStatusCanceled, ReturnStatusOpen, false},
+	}
+
+	for _, c := range cases {
+		actual := c.from.CanTransitionTo(c.to)
+		if actual != c.expected {
+			t.Errorf("%s.CanTransitionTo(%s) returned %v, expected %v", c.from, c.to, actual, c.expected)
+		}
+	}
+}
+
+func TestReturnRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/return_mutation.json")
+
+	r, err := client.Return.Request(ReturnRequest{
+		OrderID: 450789469,
+		LineItems: []ReturnRequestLineItem{{
+			FulfillmentLineItemID: "gid://shopify/FulfillmentLineItem/1031",
+			Quantity:              1,
+			ReturnReason:          ReturnReasonSizeTooSmall,
+			CustomerNote:          "Too tight",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Return.Request returned error: %v", err)
+	}
+
+	checkReturnRequest(t, request, "mutation returnRequest", map[string]interface{}{
+		"input": map[string]interface{}{
+			"orderId": "gid://shopify/Order/450789469",
+			"returnLineItems": []interface{}{map[string]interface{}{
+				"fulfillmentLineItemId": "gid://shopify/FulfillmentLineItem/1031",
+				"quantity":              float64(1),
+				"returnReason":          "SIZE_TOO_SMALL",
+				"customerNote":          "Too tight",
+			}},
+		},
+	})
+	returnTests(t, r)
+}
+
+func TestReturnGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/return.json")
+
+	r, err := client.Return.Get("gid://shopify/Return/945000954")
+	if err != nil {
+		t.Fatalf("Return.Get returned error: %v", err)
+	}
+
+	checkReturnRequest(t, request, "query return", map[string]interface{}{"id": "gid://shopify/Return/945000954"})
+	returnTests(t, r)
+}
+
+func TestReturnListByOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/order_returns.json")
+
+	returns, err := client.Return.ListByOrder(450789469)
+	if err != nil {
+		t.Fatalf("Return.ListByOrder returned error: %v", err)
+	}
+
+	checkReturnRequest(t, request, "query orderReturns", map[string]interface{}{"id": "gid://shopify/Order/450789469"})
+	if len(returns) != 1 {
+		t.Fatalf("Return.ListByOrder returned %+v", returns)
+	}
+	returnTests(t, &returns[0])
+}
+
+func TestReturnListByOrderPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []graphQLRequest
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			var request graphQLRequest
+			b, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(b, &request)
+			requests = append(requests, request)
+
+			fixture := string(loadFixture("return/order_returns.json"))
+			if len(requests) == 1 {
+				fixture = strings.Replace(fixture, `"hasNextPage": false`, `"hasNextPage": true`, 1)
+			}
+			return httpmock.NewStringResponse(200, fixture), nil
+		})
+
+	returns, err := client.Return.ListByOrder(450789469)
+	if err != nil {
+		t.Fatalf("Return.ListByOrder returned error: %v", err)
+	}
+	if len(returns) != 2 || len(requests) != 2 {
+		t.Fatalf("Return.ListByOrder returned %d returns in %d requests, expected 2 in 2", len(returns), len(requests))
+	}
+	checkReturnRequest(t, requests[1], "query orderReturns", map[string]interface{}{
+		"id":    "gid://shopify/Order/450789469",
+		"after": "eyJsYXN0X2lkIjo5NDUwMDA5NTR9",
+	})
+}
+
+func TestReturnTransitions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/return_mutation.json")
+
+	id := "gid://shopify/Return/945000954"
+	cases := []struct {
+		mutation  string
+		call      func() (*Return, error)
+		variables map[string]interface{}
+	}{
+		{
+			"returnApproveRequest",
+			func() (*Return, error) { return client.Return.Approve(id) },
+			map[string]interface{}{"input": map[string]interface{}{"id": id}},
+		},
+		{
+			"returnDeclineRequest",
+			func() (*Return, error) {
+				return client.Return.Decline(id, ReturnDeclineReasonFinalSale, "Final sale items can't be returned", true)
+			},
+			map[string]interface{}{"input": map[string]interface{}{
+				"id":             id,
+				"declineReason":  "FINAL_SALE",
+				"declineNote":    "Final sale items can't be returned",
+				"notifyCustomer": true,
+			}},
+		},
+		{
+			"returnClose",
+			func() (*Return, error) { return client.Return.Close(id) },
+			map[string]interface{}{"id": id},
+		},
+		{
+			"returnReopen",
+			func() (*Return, error) { return client.Return.Reopen(id) },
+			map[string]interface{}{"id": id},
+		},
+	}
+
+	for _, c := range cases {
+		request = graphQLRequest{}
+		r, err := c.call()
+		if err != nil {
+			t.Errorf("%s returned error: %v", c.mutation, err)
+			continue
+		}
+		checkReturnRequest(t, request, fmt.Sprintf("mutation %s", c.mutation), c.variables)
+		returnTests(t, r)
+	}
+}
+
+func TestReturnUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/user_errors.json")
+
+	_, err := client.Return.Approve("gid://shopify/Return/945000954")
+
+	expected := GraphQLMutationError{
+		Mutation: "returnApproveRequest",
+		UserErrors: []GraphQLUserError{{
+			Field:   []string{"id"},
+			Message: "Return is not in a requested state",
+			Code:    "INVALID_STATE",
+		}},
+	}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("Return.Approve returned error %#v, expected %#v", err, expected)
+	}
+
+	expectedMessage := "returnApproveRequest failed: id: Return is not in a requested state"
+	if err != nil && err.Error() != expectedMessage {
+		t.Errorf("GraphQLMutationError.Error returned %s, expected %s", err.Error(), expectedMessage)
+	}
+}
+
+func TestReturnListReturnableFulfillments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/returnable_fulfillments.json")
+
+	fulfillments, err := client.Return.ListReturnableFulfillments(450789469)
+	if err != nil {
+		t.Fatalf("Return.ListReturnableFulfillments returned error: %v", err)
+	}
+
+	checkReturnRequest(t, request, "query returnableFulfillments", map[string]interface{}{"orderId": "gid://shopify/Order/450789469"})
+	if len(fulfillments) != 1 {
+		t.Fatalf("Return.ListReturnableFulfillments returned %+v", fulfillments)
+	}
+
+	fulfillment := fulfillments[0]
+	expectedFulfillment := &Fulfillment{ID: 255858046, Status: "success"}
+	if fulfillment.ID != "gid://shopify/ReturnableFulfillment/255858046" || !reflect.DeepEqual(fulfillment.Fulfillment, expectedFulfillment) {
+		t.Errorf("ReturnableFulfillment returned %+v, expected fulfillment %+v", fulfillment, expectedFulfillment)
+	}
+	if len(fulfillment.LineItems) != 1 || fulfillment.LineItems[0].Quantity != 1 {
+		t.Fatalf("ReturnableFulfillment.LineItems returned %+v", fulfillment.LineItems)
+	}
+	fulfillmentLineItemTests(t, fulfillment.LineItems[0].FulfillmentLineItem)
+}
+
+func TestReturnGetReverseFulfillmentOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var request graphQLRequest
+	registerGraphQLResponder(&request, "return/reverse_fulfillment_order.json")
+
+	order, err := client.Return.GetReverseFulfillmentOrder("gid://shopify/ReverseFulfillmentOrder/71")
+	if err != nil {
+		t.Fatalf("Return.GetReverseFulfillmentOrder returned error: %v", err)
+	}
+
+	checkReturnRequest(t, request, "query reverseFulfillmentOrder", map[string]interface{}{"id": "gid://shopify/ReverseFulfillmentOrder/71"})
+	reverseFulfillmentOrderTests(t, order)
+}
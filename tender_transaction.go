@@ -0,0 +1,205 @@
+package goshopify
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const tenderTransactionsBasePath = "tender_transactions"
+
+// tenderTransactionExportPageSize is the number of tender transactions
+// fetched per request by ExportCSV, the maximum Shopify allows
+const tenderTransactionExportPageSize = 250
+
+// TenderTransactionPaymentMethod is how the money of a tender transaction
+// was received
+type TenderTransactionPaymentMethod string
+
+// Payment methods of tender transactions
+const (
+	TenderTransactionPaymentMethodCreditCard TenderTransactionPaymentMethod = "credit_card"
+	TenderTransactionPaymentMethodCash       TenderTransactionPaymentMethod = "cash"
+	TenderTransactionPaymentMethodAndroidPay TenderTransactionPaymentMethod = "android_pay"
+	TenderTransactionPaymentMethodApplePay   TenderTransactionPaymentMethod = "apple_pay"
+	TenderTransactionPaymentMethodGooglePay  TenderTransactionPaymentMethod = "google_pay"
+	TenderTransactionPaymentMethodSamsungPay TenderTransactionPaymentMethod = "samsung_pay"
+	TenderTransactionPaymentMethodShopifyPay TenderTransactionPaymentMethod = "shopify_pay"
+	TenderTransactionPaymentMethodAmazon     TenderTransactionPaymentMethod = "amazon"
+	TenderTransactionPaymentMethodKlarna     TenderTransactionPaymentMethod = "klarna"
+	TenderTransactionPaymentMethodPaypal     TenderTransactionPaymentMethod = "paypal"
+	TenderTransactionPaymentMethodUnknown    TenderTransactionPaymentMethod = "unknown"
+	TenderTransactionPaymentMethodOther      TenderTransactionPaymentMethod = "other"
+)
+
+// TenderTransactionService is an interface for interfacing with the tender
+// transaction endpoints of the Shopify API, the money actually received or
+// paid back for orders.
+// See: https://shopify.dev/docs/admin-api/rest/reference/tendertransaction
+type TenderTransactionService interface {
+	List(interface{}) ([]TenderTransaction, error)
+	ListWithPagination(interface{}) ([]TenderTransaction, *Pagination, error)
+	ExportCSV(io.Writer, time.Time, time.Time) error
+}
+
+// TenderTransactionServiceOp handles communication with the tender
+// transaction related methods of the Shopify API.
+type TenderTransactionServiceOp struct {
+	client *Client
+}
+
+// TenderTransaction represents a Shopify tender transaction. Refunds have a
+// negative amount.
+type TenderTransaction struct {
+	ID              int64                            `json:"id,omitempty"`
+	OrderID         int64                            `json:"order_id,omitempty"`
+	Amount          *Money                           `json:"amount,omitempty"`
+	Currency        string                           `json:"currency,omitempty"`
+	UserID          *int64                           `json:"user_id,omitempty"`
+	Test            bool                             `json:"test,omitempty"`
+	ProcessedAt     *time.Time                       `json:"processed_at,omitempty"`
+	RemoteReference string                           `json:"remote_reference,omitempty"`
+	PaymentMethod   TenderTransactionPaymentMethod   `json:"payment_method,omitempty"`
+	PaymentDetails  *TenderTransactionPaymentDetails `json:"payment_details,omitempty"`
+}
+
+// TenderTransactionPaymentDetails are the card details of a tender
+// transaction paid by credit card
+type TenderTransactionPaymentDetails struct {
+	CreditCardNumber  string `json:"credit_card_number,omitempty"`
+	CreditCardCompany string `json:"credit_card_company,omitempty"`
+}
+
+// TenderTransactionListOptions are the options to list tender transactions.
+// Order is either "processed_at ASC" or "processed_at DESC".
+type TenderTransactionListOptions struct {
+	ListOptions
+	ProcessedAtMin time.Time `url:"processed_at_min,omitempty"`
+	ProcessedAtMax time.Time `url:"processed_at_max,omitempty"`
+	ProcessedAt    time.Time `url:"processed_at,omitempty"`
+}
+
+// TenderTransactionsResource represents the result from the
+// tender_transactions.json endpoint
+type TenderTransactionsResource struct {
+	TenderTransactions []TenderTransaction `json:"tender_transactions"`
+}
+
+// List tender transactions
+func (s *TenderTransactionServiceOp) List(options interface{}) ([]TenderTransaction, error) {
+	tenderTransactions, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return tenderTransactions, nil
+}
+
+// ListWithPagination lists tender transactions and return pagination to
+// retrieve next/previous results.
+func (s *TenderTransactionServiceOp) ListWithPagination(options interface{}) ([]TenderTransaction, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", tenderTransactionsBasePath)
+	resource := new(TenderTransactionsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.TenderTransactions, pagination, nil
+}
+
+// tenderTransactionCSVHeader are the columns written by ExportCSV
+var tenderTransactionCSVHeader = []string{
+	"id",
+	"order_id",
+	"processed_at",
+	"amount",
+	"currency",
+	"payment_method",
+	"credit_card_company",
+	"credit_card_number",
+	"remote_reference",
+	"user_id",
+	"test",
+}
+
+// csvRecord returns the tender transaction as a row of ExportCSV
+func (t TenderTransaction) csvRecord() []string {
+	record := []string{
+		strconv.FormatInt(t.ID, 10),
+		strconv.FormatInt(t.OrderID, 10),
+		"",
+		"",
+		t.Currency,
+		string(t.PaymentMethod),
+		"",
+		"",
+		t.RemoteReference,
+		"",
+		strconv.FormatBool(t.Test),
+	}
+	if t.ProcessedAt != nil {
+		record[2] = t.ProcessedAt.Format(time.RFC3339)
+	}
+	if t.Amount != nil {
+		record[3] = t.Amount.String()
+	}
+	if t.PaymentDetails != nil {
+		record[6] = t.PaymentDetails.CreditCardCompany
+		record[7] = t.PaymentDetails.CreditCardNumber
+	}
+	if t.UserID != nil {
+		record[9] = strconv.FormatInt(*t.UserID, 10)
+	}
+	return record
+}
+
+// ExportCSV writes the tender transactions processed between processedAtMin
+// and processedAtMax to w as CSV, oldest first, with a header row. Each page
+// is written as soon as it is fetched, so exports of long periods don't have
+// to fit in memory.
+func (s *TenderTransactionServiceOp) ExportCSV(w io.Writer, processedAtMin time.Time, processedAtMax time.Time) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(tenderTransactionCSVHeader); err != nil {
+		return err
+	}
+
+	var options interface{} = TenderTransactionListOptions{
+		ListOptions:    ListOptions{Limit: tenderTransactionExportPageSize, Order: "processed_at ASC"},
+		ProcessedAtMin: processedAtMin,
+		ProcessedAtMax: processedAtMax,
+	}
+	for {
+		tenderTransactions, pagination, err := s.ListWithPagination(options)
+		if err != nil {
+			return err
+		}
+
+		for _, tenderTransaction := range tenderTransactions {
+			if err := writer.Write(tenderTransaction.csvRecord()); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+
+		if pagination == nil || pagination.NextPageOptions == nil {
+			return nil
+		}
+		options = pagination.NextPageOptions
+	}
+}
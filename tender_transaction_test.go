@@ -0,0 +1,153 @@
+package goshopify
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func tenderTransactionTests(t *testing.T, tenderTransaction TenderTransaction) {
+	processedAt := time.Date(2020, time.October, 5, 17, 55, 40, 0, time.UTC)
+	expected := TenderTransaction{
+		ID:              1011222896,
+		OrderID:         450789469,
+		Currency:        "USD",
+		ProcessedAt:     &processedAt,
+		RemoteReference: "1001",
+		PaymentMethod:   TenderTransactionPaymentMethodCreditCard,
+		PaymentDetails: &TenderTransactionPaymentDetails{
+			CreditCardNumber:  "•••• •••• •••• 1",
+			CreditCardCompany: "Bogus",
+		},
+	}
+
+	expectedAmount := decimal.RequireFromString("250.94")
+	if tenderTransaction.Amount == nil || !tenderTransaction.Amount.Equal(expectedAmount) {
+		t.Errorf("TenderTransaction.Amount returned %v, expected %v", tenderTransaction.Amount, expectedAmount)
+	}
+	if tenderTransaction.ProcessedAt == nil || !tenderTransaction.ProcessedAt.Equal(processedAt) {
+		t.Errorf("TenderTransaction.ProcessedAt returned %v, expected %v", tenderTransaction.ProcessedAt, processedAt)
+	}
+
+	tenderTransaction.Amount = nil
+	tenderTransaction.ProcessedAt = &processedAt
+	if !reflect.DeepEqual(tenderTransaction, expected) {
+		t.Errorf("TenderTransaction returned %+v, expected %+v", tenderTransaction, expected)
+	}
+}
+
+func TestTenderTransactionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{
+		"processed_at_min": "2020-10-01T00:00:00Z",
+		"processed_at_max": "2020-10-31T00:00:00Z",
+	}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		params, httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	tenderTransactions, err := client.TenderTransaction.List(TenderTransactionListOptions{
+		ProcessedAtMin: time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC),
+		ProcessedAtMax: time.Date(2020, time.October, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("TenderTransaction.List returned error: %v", err)
+	}
+
+	if len(tenderTransactions) != 2 {
+		t.Fatalf("TenderTransaction.List returned %d tender transactions, expected 2", len(tenderTransactions))
+	}
+	tenderTransactionTests(t, tenderTransactions[0])
+
+	refund := tenderTransactions[1]
+	if refund.PaymentMethod != TenderTransactionPaymentMethodCash || refund.PaymentDetails != nil ||
+		refund.UserID == nil || *refund.UserID != 548380009 || !refund.Amount.Equal(decimal.New(-10, 0)) {
+		t.Errorf("TenderTransaction.List returned %+v", refund)
+	}
+}
+
+func TestTenderTransactionListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("tender_transactions.json"))
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	tenderTransactions, pagination, err := client.TenderTransaction.ListWithPagination(TenderTransactionListOptions{ListOptions: ListOptions{Limit: 2}})
+	if err != nil {
+		t.Fatalf("TenderTransaction.ListWithPagination returned error: %v", err)
+	}
+
+	if len(tenderTransactions) != 2 {
+		t.Fatalf("TenderTransaction.ListWithPagination returned %d tender transactions, expected 2", len(tenderTransactions))
+	}
+	tenderTransactionTests(t, tenderTransactions[0])
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "foo", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("TenderTransaction.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestTenderTransactionExportCSV(t *testing.T) {
+	setup()
+	defer teardown()
+
+	firstPage := map[string]string{
+		"limit":            "250",
+		"order":            "processed_at ASC",
+		"processed_at_min": "2020-10-01T00:00:00Z",
+		"processed_at_max": "2020-10-31T00:00:00Z",
+	}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		firstPage, func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("tender_transactions.json"))
+			resp.Header.Set("Link", `<http://valid.url?page_info=foo&limit=250>; rel="next"`)
+			return resp, nil
+		})
+
+	secondPage := map[string]string{"page_info": "foo", "limit": "250"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		secondPage, httpmock.NewStringResponder(200, `{"tender_transactions": [{"id": 1011222898, "order_id": 1, "amount": "5.00", "currency": "USD", "payment_method": "paypal"}]}`))
+
+	var b bytes.Buffer
+	err := client.TenderTransaction.ExportCSV(&b,
+		time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.October, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("TenderTransaction.ExportCSV returned error: %v", err)
+	}
+
+	expected := "id,order_id,processed_at,amount,currency,payment_method,credit_card_company,credit_card_number,remote_reference,user_id,test\n" +
+		"1011222896,450789469,2020-10-05T13:55:40-04:00,250.94,USD,credit_card,Bogus,•••• •••• •••• 1,1001,,false\n" +
+		"1011222897,450789469,2020-10-06T09:12:02-04:00,-10,USD,cash,,,\"refund, 1002\",548380009,false\n" +
+		"1011222898,1,,5,USD,paypal,,,,,false\n"
+	if b.String() != expected {
+		t.Errorf("TenderTransaction.ExportCSV wrote\n%s\nexpected\n%s", b.String(), expected)
+	}
+}
+
+func TestTenderTransactionExportCSVError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, `{"errors": "Internal Server Error"}`))
+
+	var b bytes.Buffer
+	err := client.TenderTransaction.ExportCSV(&b, time.Time{}, time.Now())
+	if err == nil {
+		t.Error("TenderTransaction.ExportCSV returned no error")
+	}
+}
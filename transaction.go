@@ -1,6 +1,36 @@
 package goshopify
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Kinds of transactions
+const (
+	TransactionKindAuthorization   = "authorization"
+	TransactionKindCapture         = "capture"
+	TransactionKindSale            = "sale"
+	TransactionKindVoid            = "void"
+	TransactionKindRefund          = "refund"
+	TransactionKindSuggestedRefund = "suggested_refund"
+)
+
+// Statuses of transactions
+const (
+	TransactionStatusPending = "pending"
+	TransactionStatusFailure = "failure"
+	TransactionStatusSuccess = "success"
+	TransactionStatusError   = "error"
+)
+
+// Statuses of refunds processed by Shopify Payments
+const (
+	PaymentsRefundStatusPending = "pending"
+	PaymentsRefundStatusFailure = "failure"
+	PaymentsRefundStatusSuccess = "success"
+	PaymentsRefundStatusError   = "error"
+)
 
 // TransactionService is an interface for interfacing with the transactions endpoints of
 // the Shopify API.
@@ -10,6 +40,10 @@ type TransactionService interface {
 	Count(int64, interface{}) (int, error)
 	Get(int64, int64, interface{}) (*Transaction, error)
 	Create(int64, Transaction) (*Transaction, error)
+	Capture(int64, int64, decimal.Decimal, string) (*Transaction, error)
+	Void(int64, int64) (*Transaction, error)
+	Refund(int64, int64, decimal.Decimal, string) (*Transaction, error)
+	OutstandingAuthorizedAmount(int64) (*Money, error)
 }
 
 // TransactionServiceOp handles communication with the transaction related methods of the
@@ -18,6 +52,33 @@ type TransactionServiceOp struct {
 	client *Client
 }
 
+// TransactionListOptions are the options to list transactions. With
+// InShopCurrency, amounts are in the shop currency instead of the currency the
+// transactions were processed in.
+type TransactionListOptions struct {
+	SinceID        int64  `url:"since_id,omitempty"`
+	Fields         string `url:"fields,omitempty"`
+	InShopCurrency bool   `url:"in_shop_currency,omitempty"`
+}
+
+// CurrencyExchangeAdjustment is the difference between the amount of a
+// transaction in the shop currency at the time of the order and when it was
+// processed
+type CurrencyExchangeAdjustment struct {
+	ID             int64            `json:"id,omitempty"`
+	Adjustment     *decimal.Decimal `json:"adjustment,omitempty"`
+	OriginalAmount *decimal.Decimal `json:"original_amount,omitempty"`
+	FinalAmount    *decimal.Decimal `json:"final_amount,omitempty"`
+	Currency       string           `json:"currency,omitempty"`
+}
+
+// PaymentsRefundAttributes are the details of a refund processed by Shopify
+// Payments
+type PaymentsRefundAttributes struct {
+	Status                  string `json:"status,omitempty"`
+	AcquirerReferenceNumber string `json:"acquirer_reference_number,omitempty"`
+}
+
 // TransactionResource represents the result from the orders/X/transactions/Y.json endpoint
 type TransactionResource struct {
 	Transaction *Transaction `json:"transaction"`
@@ -58,3 +119,94 @@ func (s *TransactionServiceOp) Create(orderID int64, transaction Transaction) (*
 	err := s.client.Post(path, wrappedData, resource)
 	return resource.Transaction, err
 }
+
+// Capture an amount of an authorization, in the currency of the order
+func (s *TransactionServiceOp) Capture(orderID int64, parentID int64, amount decimal.Decimal, currency string) (*Transaction, error) {
+	return s.Create(orderID, Transaction{
+		Kind:     TransactionKindCapture,
+		ParentID: &parentID,
+		Amount:   NewMoney(amount, currency),
+		Currency: currency,
+	})
+}
+
+// Void an authorization
+func (s *TransactionServiceOp) Void(orderID int64, parentID int64) (*Transaction, error) {
+	return s.Create(orderID, Transaction{
+		Kind:     TransactionKindVoid,
+		ParentID: &parentID,
+	})
+}
+
+// Refund an amount of a capture or sale, in the currency of the order
+func (s *TransactionServiceOp) Refund(orderID int64, parentID int64, amount decimal.Decimal, currency string) (*Transaction, error) {
+	return s.Create(orderID, Transaction{
+		Kind:     TransactionKindRefund,
+		ParentID: &parentID,
+		Amount:   NewMoney(amount, currency),
+		Currency: currency,
+	})
+}
+
+// OutstandingAuthorizedAmount returns the amount authorized on an order that
+// is neither captured nor voided
+func (s *TransactionServiceOp) OutstandingAuthorizedAmount(orderID int64) (*Money, error) {
+	transactions, err := s.List(orderID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return OutstandingAuthorizedAmount(transactions)
+}
+
+// amount returns the amount of the transaction, zero if it has none
+func (t Transaction) amount() decimal.Decimal {
+	if t.Amount == nil {
+		return decimal.Zero
+	}
+	return t.Amount.Decimal
+}
+
+// OutstandingAuthorizedAmount returns the amount of the successful
+// authorizations of an order that is neither captured nor voided. The money is
+// in the currency of the authorizations, authorizations in different
+// currencies return a CurrencyMismatchError.
+func OutstandingAuthorizedAmount(transactions []Transaction) (*Money, error) {
+	outstanding := map[int64]decimal.Decimal{}
+	currency := ""
+	for _, transaction := range transactions {
+		if transaction.Kind == TransactionKindAuthorization && transaction.Status == TransactionStatusSuccess {
+			if currency != "" && transaction.Currency != currency {
+				return nil, CurrencyMismatchError{Expected: currency, Actual: transaction.Currency}
+			}
+			outstanding[transaction.ID] = transaction.amount()
+			currency = transaction.Currency
+		}
+	}
+
+	for _, transaction := range transactions {
+		if transaction.ParentID == nil || transaction.Status != TransactionStatusSuccess {
+			continue
+		}
+		remaining, ok := outstanding[*transaction.ParentID]
+		if !ok {
+			continue
+		}
+
+		switch transaction.Kind {
+		case TransactionKindCapture:
+			remaining = remaining.Sub(transaction.amount())
+			if remaining.IsNegative() {
+				remaining = decimal.Zero
+			}
+		case TransactionKindVoid:
+			remaining = decimal.Zero
+		}
+		outstanding[*transaction.ParentID] = remaining
+	}
+
+	total := decimal.Zero
+	for _, remaining := range outstanding {
+		total = total.Add(remaining)
+	}
+	return NewMoney(total, currency), nil
+}
@@ -2,6 +2,9 @@ package goshopify
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
@@ -177,6 +180,29 @@ func TestTransactionGet(t *testing.T) {
 	TransactionTests(t, *transaction)
 }
 
+func TestTransactionGetTotalUnsettledSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/transactions/1.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("transaction_unsettled.json")))
+
+	transaction, err := client.Transaction.Get(1, 1, nil)
+	if err != nil {
+		t.Fatalf("Transaction.Get returned error: %v", err)
+	}
+
+	if transaction.TotalUnsettledSet == nil {
+		t.Fatal("Transaction.TotalUnsettledSet is nil")
+	}
+
+	shop, _ := transaction.TotalUnsettledSet.In("USD")
+	moneyTests(t, "Transaction.TotalUnsettledSet.In", shop, "118.00", "USD")
+
+	presentment, _ := transaction.TotalUnsettledSet.In("CAD")
+	moneyTests(t, "Transaction.TotalUnsettledSet.In", presentment, "159.30", "CAD")
+}
+
 func TestTransactionCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -187,7 +213,7 @@ func TestTransactionCreate(t *testing.T) {
 	amount := decimal.NewFromFloat(409.94)
 
 	transaction := Transaction{
-		Amount: &amount,
+		Amount: NewMoney(amount, ""),
 	}
 	result, err := client.Transaction.Create(1, transaction)
 	if err != nil {
@@ -195,3 +221,151 @@ func TestTransactionCreate(t *testing.T) {
 	}
 	TransactionTests(t, *result)
 }
+
+func TestTransactionListInShopCurrency(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"in_shop_currency": "true"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/transactions.json", client.pathPrefix),
+		params, httpmock.NewBytesResponder(200, loadFixture("transactions.json")))
+
+	transactions, err := client.Transaction.List(1, TransactionListOptions{InShopCurrency: true})
+	if err != nil {
+		t.Errorf("Transaction.List returned error: %v", err)
+	}
+
+	if len(transactions) != 1 {
+		t.Fatalf("Transaction.List returned %d transactions, expected 1", len(transactions))
+	}
+	TransactionTests(t, transactions[0])
+}
+
+func TestTransactionRefundAttributes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/transactions.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("transactions_authorized.json")))
+
+	transactions, err := client.Transaction.List(450789469, nil)
+	if err != nil {
+		t.Fatalf("Transaction.List returned error: %v", err)
+	}
+
+	refund := transactions[len(transactions)-1]
+	if refund.Receipt["refund_id"] != "re_1234" {
+		t.Errorf("Transaction.Receipt returned %+v", refund.Receipt)
+	}
+
+	adjustment := refund.CurrencyExchangeAdjustment
+	if adjustment == nil || adjustment.Currency != "CAD" || !adjustment.Adjustment.Equal(decimal.RequireFromString("-0.12")) ||
+		!adjustment.OriginalAmount.Equal(decimal.RequireFromString("13.50")) || !adjustment.FinalAmount.Equal(decimal.RequireFromString("13.38")) {
+		t.Errorf("Transaction.CurrencyExchangeAdjustment returned %+v", adjustment)
+	}
+
+	expectedAttributes := &PaymentsRefundAttributes{Status: PaymentsRefundStatusSuccess, AcquirerReferenceNumber: "123456789012345"}
+	if !reflect.DeepEqual(refund.PaymentsRefundAttributes, expectedAttributes) {
+		t.Errorf("Transaction.PaymentsRefundAttributes returned %+v, expected %+v", refund.PaymentsRefundAttributes, expectedAttributes)
+	}
+}
+
+func TestTransactionCaptureVoidRefund(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/transactions.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("transaction.json")), nil
+		})
+
+	cases := []struct {
+		name         string
+		call         func() (*Transaction, error)
+		expectedBody string
+	}{
+		{
+			"Capture",
+			func() (*Transaction, error) {
+				return client.Transaction.Capture(1, 389404469, decimal.RequireFromString("10.50"), "USD")
+			},
+			`{"transaction":{"amount":"10.5","kind":"capture","currency":"USD","parent_id":389404469}}`,
+		},
+		{
+			"Void",
+			func() (*Transaction, error) { return client.Transaction.Void(1, 389404469) },
+			`{"transaction":{"kind":"void","parent_id":389404469}}`,
+		},
+		{
+			"Refund",
+			func() (*Transaction, error) {
+				return client.Transaction.Refund(1, 389404469, decimal.RequireFromString("5"), "CAD")
+			},
+			`{"transaction":{"amount":"5","kind":"refund","currency":"CAD","parent_id":389404469}}`,
+		},
+	}
+
+	for _, c := range cases {
+		transaction, err := c.call()
+		if err != nil {
+			t.Errorf("Transaction.%s returned error: %v", c.name, err)
+			continue
+		}
+		if body != c.expectedBody {
+			t.Errorf("Transaction.%s sent %s, expected %s", c.name, body, c.expectedBody)
+		}
+		TransactionTests(t, *transaction)
+	}
+}
+
+func TestTransactionOutstandingAuthorizedAmount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/transactions.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("transactions_authorized.json")))
+
+	amount, err := client.Transaction.OutstandingAuthorizedAmount(450789469)
+	if err != nil {
+		t.Fatalf("Transaction.OutstandingAuthorizedAmount returned error: %v", err)
+	}
+
+	expected := decimal.RequireFromString("70")
+	if !amount.Equal(expected) || amount.CurrencyCode != "USD" {
+		t.Errorf("Transaction.OutstandingAuthorizedAmount returned %v %s, expected %v USD", amount, amount.CurrencyCode, expected)
+	}
+}
+
+func TestOutstandingAuthorizedAmountOverCaptured(t *testing.T) {
+	parentID := int64(1)
+	transactions := []Transaction{
+		{ID: 1, Kind: TransactionKindAuthorization, Status: TransactionStatusSuccess, Amount: NewMoney(decimal.New(10, 0), "USD"), Currency: "USD"},
+		{ID: 2, Kind: TransactionKindCapture, Status: TransactionStatusSuccess, Amount: NewMoney(decimal.New(12, 0), "USD"), ParentID: &parentID},
+	}
+
+	amount, err := OutstandingAuthorizedAmount(transactions)
+	if err != nil || !amount.IsZero() {
+		t.Errorf("OutstandingAuthorizedAmount returned %v, %v, expected 0", amount, err)
+	}
+
+	amount, err = OutstandingAuthorizedAmount(nil)
+	if err != nil || !amount.IsZero() || amount.CurrencyCode != "" {
+		t.Errorf("OutstandingAuthorizedAmount returned %v %s, %v for no transactions", amount, amount.CurrencyCode, err)
+	}
+}
+
+func TestOutstandingAuthorizedAmountMixedCurrencies(t *testing.T) {
+	transactions := []Transaction{
+		{ID: 1, Kind: TransactionKindAuthorization, Status: TransactionStatusSuccess, Amount: NewMoney(decimal.New(10, 0), "USD"), Currency: "USD"},
+		{ID: 2, Kind: TransactionKindAuthorization, Status: TransactionStatusSuccess, Amount: NewMoney(decimal.New(10, 0), "CAD"), Currency: "CAD"},
+	}
+
+	_, err := OutstandingAuthorizedAmount(transactions)
+	expected := CurrencyMismatchError{Expected: "USD", Actual: "CAD"}
+	if err != expected {
+		t.Errorf("OutstandingAuthorizedAmount returned error %v, expected %v", err, expected)
+	}
+}
@@ -3,6 +3,7 @@ package goshopify
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -26,12 +27,14 @@ type UsageChargeServiceOp struct {
 
 // UsageCharge represents a Shopify UsageCharge.
 type UsageCharge struct {
-	BalanceRemaining       *decimal.Decimal `json:"balance_remaining,omitempty"`
-	BalanceUsed            *decimal.Decimal `json:"balance_used,omitempty"`
+	BalanceRemaining       *Money           `json:"balance_remaining,omitempty"`
+	BalanceUsed            *Money           `json:"balance_used,omitempty"`
+	BillingOn              *time.Time       `json:"billing_on,omitempty"`
+	CreatedAt              *time.Time       `json:"created_at,omitempty"`
 	Description            string           `json:"description,omitempty"`
 	IdempotencyKey         string           `json:"idempotencyKey"`
 	ID                     int64            `json:"id,omitempty"`
-	Price                  *decimal.Decimal `json:"price,omitempty"`
+	Price                  *Money           `json:"price,omitempty"`
 	CurrencyCode           string           `json:"currencyCode"`
 	SubscriptionLineItemID string           `json:"subscriptionLineItemId"`
 	RiskLevel              *decimal.Decimal `json:"risk_level,omitempty"`
@@ -51,6 +54,9 @@ func (r *UsageCharge) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	if err := parse(&r.BillingOn, aux.BillingOn); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20,11 +20,11 @@ func usageChargeTests(t *testing.T, usageCharge UsageCharge) {
 	expected := UsageCharge{
 		ID:               1034618208,
 		Description:      "Super Mega Plan 1000 emails",
-		Price:            &price,
+		Price:            NewMoney(price, ""),
 		CreatedAt:        &createdAt,
 		BillingOn:        &billingOn,
-		BalanceRemaining: &balancedRemaining,
-		BalanceUsed:      &balanceUsed,
+		BalanceRemaining: NewMoney(balancedRemaining, ""),
+		BalanceUsed:      NewMoney(balanceUsed, ""),
 		RiskLevel:        &riskLevel,
 	}
 
@@ -34,7 +34,7 @@ func usageChargeTests(t *testing.T, usageCharge UsageCharge) {
 	if usageCharge.Description != expected.Description {
 		t.Errorf("UsageCharge.Description returned %v, expected %v", usageCharge.Description, expected.Description)
 	}
-	if !usageCharge.Price.Equal(*expected.Price) {
+	if !usageCharge.Price.Equal(expected.Price.Decimal) {
 		t.Errorf("UsageCharge.Price returned %v, expected %v", usageCharge.Price, expected.Price)
 	}
 	if !usageCharge.CreatedAt.Equal(*expected.CreatedAt) {
@@ -43,10 +43,10 @@ func usageChargeTests(t *testing.T, usageCharge UsageCharge) {
 	if !usageCharge.BillingOn.Equal(*expected.BillingOn) {
 		t.Errorf("UsageCharge.BillingOn returned %v, expected %v", usageCharge.BillingOn, expected.BillingOn)
 	}
-	if !usageCharge.BalanceRemaining.Equal(*expected.BalanceRemaining) {
+	if !usageCharge.BalanceRemaining.Equal(expected.BalanceRemaining.Decimal) {
 		t.Errorf("UsageCharge.BalanceRemaining returned %v, expected %v", usageCharge.BalanceRemaining, expected.BalanceRemaining)
 	}
-	if !usageCharge.BalanceUsed.Equal(*expected.BalanceUsed) {
+	if !usageCharge.BalanceUsed.Equal(expected.BalanceUsed.Decimal) {
 		t.Errorf("UsageCharge.BalanceUsed returned %v, expected %v", usageCharge.BalanceUsed, expected.BalanceUsed)
 	}
 	if !usageCharge.RiskLevel.Equal(*expected.RiskLevel) {
@@ -69,7 +69,7 @@ func TestUsageChargeServiceOp_Create(t *testing.T) {
 	p := decimal.NewFromFloat(1.0)
 	charge := UsageCharge{
 		Description: "Super Mega Plan 1000 emails",
-		Price:       &p,
+		Price:       NewMoney(p, ""),
 	}
 
 	returnedCharge, err := client.UsageCharge.Create(455696195, charge)
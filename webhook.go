@@ -2,21 +2,34 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 )
 
 const webhooksBasePath = "webhooks"
 
+// Kinds of webhook addresses, see Webhook.AddressKind
+const (
+	WebhookAddressHTTP        = "http"
+	WebhookAddressEventBridge = "eventbridge"
+	WebhookAddressPubSub      = "pubsub"
+)
+
 // WebhookService is an interface for interfacing with the webhook endpoints of
 // the Shopify API.
 // See: https://help.shopify.com/api/reference/webhook
 type WebhookService interface {
 	List(interface{}) ([]Webhook, error)
+	ListWithPagination(interface{}) ([]Webhook, *Pagination, error)
 	Count(interface{}) (int, error)
 	Get(int64, interface{}) (*Webhook, error)
 	Create(Webhook) (*Webhook, error)
 	Update(Webhook) (*Webhook, error)
 	Delete(int64) error
+	PlanReconcile([]Webhook) (*WebhookPlan, error)
+	ApplyPlan(*WebhookPlan) error
+	Reconcile([]Webhook) (*WebhookPlan, error)
 }
 
 // WebhookServiceOp handles communication with the webhook-related methods of
@@ -37,6 +50,21 @@ type Webhook struct {
 	MetafieldNamespaces []string   `json:"metafield_namespaces"`
 }
 
+// AddressKind returns how the webhook is delivered: WebhookAddressHTTP for
+// https endpoints, WebhookAddressEventBridge for Amazon EventBridge ARNs
+// (arn:aws:events:...) and WebhookAddressPubSub for Google Cloud Pub/Sub
+// topics (pubsub://project:topic).
+func (w Webhook) AddressKind() string {
+	switch {
+	case strings.HasPrefix(w.Address, "arn:aws:events:"):
+		return WebhookAddressEventBridge
+	case strings.HasPrefix(w.Address, "pubsub://"):
+		return WebhookAddressPubSub
+	default:
+		return WebhookAddressHTTP
+	}
+}
+
 // WebhookOptions can be used for filtering webhooks on a List request.
 type WebhookOptions struct {
 	Address string `url:"address,omitempty"`
@@ -55,10 +83,34 @@ type WebhooksResource struct {
 
 // List webhooks
 func (s *WebhookServiceOp) List(options interface{}) ([]Webhook, error) {
+	webhooks, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// ListWithPagination lists webhooks and return pagination to retrieve
+// next/previous results.
+func (s *WebhookServiceOp) ListWithPagination(options interface{}) ([]Webhook, *Pagination, error) {
 	path := fmt.Sprintf("%s.json", webhooksBasePath)
 	resource := new(WebhooksResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Webhooks, err
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Webhooks, pagination, nil
 }
 
 // Count webhooks
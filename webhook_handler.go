@@ -0,0 +1,452 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Headers Shopify sends along with every webhook delivery.
+const (
+	webhookTopicHeader       = "X-Shopify-Topic"
+	webhookShopDomainHeader  = "X-Shopify-Shop-Domain"
+	webhookIDHeader          = "X-Shopify-Webhook-Id"
+	webhookEventIDHeader     = "X-Shopify-Event-Id"
+	webhookAPIVersionHeader  = "X-Shopify-API-Version"
+	webhookTriggeredAtHeader = "X-Shopify-Triggered-At"
+)
+
+// Commonly used webhook topics.
+// See: https://shopify.dev/api/admin-rest/latest/resources/webhook#event-topics
+const (
+	WebhookTopicAppUninstalled           = "app/uninstalled"
+	WebhookTopicCustomersCreate          = "customers/create"
+	WebhookTopicCustomersUpdate          = "customers/update"
+	WebhookTopicCustomersDelete          = "customers/delete"
+	WebhookTopicCustomersDataRequest     = "customers/data_request"
+	WebhookTopicCustomersRedact          = "customers/redact"
+	WebhookTopicDraftOrdersCreate        = "draft_orders/create"
+	WebhookTopicDraftOrdersUpdate        = "draft_orders/update"
+	WebhookTopicDraftOrdersDelete        = "draft_orders/delete"
+	WebhookTopicFulfillmentsCreate       = "fulfillments/create"
+	WebhookTopicFulfillmentsUpdate       = "fulfillments/update"
+	WebhookTopicInventoryItemsCreate     = "inventory_items/create"
+	WebhookTopicInventoryItemsUpdate     = "inventory_items/update"
+	WebhookTopicInventoryItemsDelete     = "inventory_items/delete"
+	WebhookTopicLocationsCreate          = "locations/create"
+	WebhookTopicLocationsUpdate          = "locations/update"
+	WebhookTopicLocationsDelete          = "locations/delete"
+	WebhookTopicOrdersCreate             = "orders/create"
+	WebhookTopicOrdersUpdated            = "orders/updated"
+	WebhookTopicOrdersPaid               = "orders/paid"
+	WebhookTopicOrdersFulfilled          = "orders/fulfilled"
+	WebhookTopicOrdersPartiallyFulfilled = "orders/partially_fulfilled"
+	WebhookTopicOrdersCancelled          = "orders/cancelled"
+	WebhookTopicOrdersDelete             = "orders/delete"
+	WebhookTopicOrderTransactionsCreate  = "order_transactions/create"
+	WebhookTopicProductsCreate           = "products/create"
+	WebhookTopicProductsUpdate           = "products/update"
+	WebhookTopicProductsDelete           = "products/delete"
+	WebhookTopicCollectionsCreate        = "collections/create"
+	WebhookTopicCollectionsUpdate        = "collections/update"
+	WebhookTopicCollectionsDelete        = "collections/delete"
+	WebhookTopicRefundsCreate            = "refunds/create"
+	WebhookTopicShopUpdate               = "shop/update"
+	WebhookTopicShopRedact               = "shop/redact"
+	WebhookTopicThemesPublish            = "themes/publish"
+	WebhookTopicThemesUpdate             = "themes/update"
+)
+
+// webhookPayloadTypes maps topics, or the resource their topics start with,
+// to the struct their body decodes into. Topics take precedence over
+// resources.
+var webhookPayloadTypes = map[string]reflect.Type{
+	"orders":             reflect.TypeOf(Order{}),
+	"draft_orders":       reflect.TypeOf(DraftOrder{}),
+	"products":           reflect.TypeOf(Product{}),
+	"collections":        reflect.TypeOf(Collection{}),
+	"customers":          reflect.TypeOf(Customer{}),
+	"fulfillments":       reflect.TypeOf(Fulfillment{}),
+	"inventory_items":    reflect.TypeOf(InventoryItem{}),
+	"locations":          reflect.TypeOf(Location{}),
+	"order_transactions": reflect.TypeOf(Transaction{}),
+	"refunds":            reflect.TypeOf(Refund{}),
+	"shop":               reflect.TypeOf(Shop{}),
+	"themes":             reflect.TypeOf(Theme{}),
+
+	WebhookTopicCustomersDataRequest: reflect.TypeOf(CustomerDataRequest{}),
+	WebhookTopicCustomersRedact:      reflect.TypeOf(CustomerRedactRequest{}),
+	WebhookTopicShopRedact:           reflect.TypeOf(ShopRedactRequest{}),
+}
+
+// webhookPayloadType returns the struct the body of a topic decodes into
+func webhookPayloadType(topic string) (reflect.Type, bool) {
+	if payloadType, ok := webhookPayloadTypes[topic]; ok {
+		return payloadType, true
+	}
+	if i := strings.IndexByte(topic, '/'); i >= 0 {
+		payloadType, ok := webhookPayloadTypes[topic[:i]]
+		return payloadType, ok
+	}
+	return nil, false
+}
+
+// NewWebhookPayload returns a pointer to a new struct matching the topic, e.g.
+// an *Order for orders/create or a *Product for products/update, or nil for
+// unknown topics.
+func NewWebhookPayload(topic string) interface{} {
+	payloadType, ok := webhookPayloadType(topic)
+	if !ok {
+		return nil
+	}
+	return reflect.New(payloadType).Interface()
+}
+
+// WebhookDelivery represents a single webhook delivered by Shopify: the
+// Shopify headers and the raw JSON body.
+type WebhookDelivery struct {
+	Topic       string
+	ShopDomain  string
+	WebhookID   string
+	EventID     string
+	APIVersion  string
+	TriggeredAt *time.Time
+	Body        []byte
+}
+
+// NewWebhookDelivery reads the Shopify webhook headers into a WebhookDelivery
+// for the given body.
+func NewWebhookDelivery(header http.Header, body []byte) *WebhookDelivery {
+	delivery := &WebhookDelivery{
+		Topic:      header.Get(webhookTopicHeader),
+		ShopDomain: header.Get(webhookShopDomainHeader),
+		WebhookID:  header.Get(webhookIDHeader),
+		EventID:    header.Get(webhookEventIDHeader),
+		APIVersion: header.Get(webhookAPIVersionHeader),
+		Body:       body,
+	}
+
+	if triggeredAt, err := time.Parse(time.RFC3339Nano, header.Get(webhookTriggeredAtHeader)); err == nil {
+		delivery.TriggeredAt = &triggeredAt
+	}
+
+	return delivery
+}
+
+// Decode unmarshals the webhook body into v.
+func (d *WebhookDelivery) Decode(v interface{}) error {
+	err := json.Unmarshal(d.Body, v)
+	if err != nil {
+		return WebhookDecodingError{
+			Topic:   d.Topic,
+			Body:    d.Body,
+			Message: err.Error(),
+		}
+	}
+	return nil
+}
+
+// Payload decodes the webhook body into the struct matching its topic, see
+// NewWebhookPayload. It returns nil for unknown topics.
+func (d *WebhookDelivery) Payload() (interface{}, error) {
+	payload := NewWebhookPayload(d.Topic)
+	if payload == nil {
+		return nil, nil
+	}
+	if err := d.Decode(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// WebhookDecodingError occurs when the body of a webhook could not be parsed
+// into the type registered for its topic.
+type WebhookDecodingError struct {
+	Topic   string
+	Body    []byte
+	Message string
+}
+
+func (e WebhookDecodingError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Topic, e.Message)
+}
+
+// WebhookHandlerFunc handles a webhook delivery. Returning an error makes
+// Shopify retry the delivery later.
+type WebhookHandlerFunc func(*WebhookDelivery) error
+
+// WebhookMiddleware wraps a WebhookHandlerFunc, e.g. to skip deliveries that
+// were already handled.
+type WebhookMiddleware func(WebhookHandlerFunc) WebhookHandlerFunc
+
+// WebhookHandler is an http.Handler that receives Shopify webhooks. It
+// verifies the HMAC of every request and dispatches it to the handler
+// registered for its topic, or else to the typed handler for the struct
+// matching the topic, which receives the decoded body. Deliveries without a
+// handler are passed to the fallback handler, or acknowledged if there is
+// none.
+type WebhookHandler struct {
+	app App
+
+	mu          sync.RWMutex
+	handlers    map[string]WebhookHandlerFunc
+	typed       map[reflect.Type]WebhookHandlerFunc
+	fallback    WebhookHandlerFunc
+	middlewares []WebhookMiddleware
+}
+
+// NewWebhookHandler returns a WebhookHandler verifying deliveries with the
+// app's ApiSecret.
+func NewWebhookHandler(app App) *WebhookHandler {
+	return &WebhookHandler{
+		app:      app,
+		handlers: make(map[string]WebhookHandlerFunc),
+		typed:    make(map[reflect.Type]WebhookHandlerFunc),
+	}
+}
+
+// Handle registers the handler for the given topics, replacing any handler
+// previously registered for them.
+func (h *WebhookHandler) Handle(handler WebhookHandlerFunc, topics ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, topic := range topics {
+		h.handlers[topic] = handler
+	}
+}
+
+// handlePayload registers the handler for the given topics, or for all the
+// topics whose payload is of the type of payload if none are given.
+func (h *WebhookHandler) handlePayload(payload interface{}, handler WebhookHandlerFunc, topics []string) {
+	if len(topics) > 0 {
+		h.Handle(handler, topics...)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.typed[reflect.TypeOf(payload).Elem()] = handler
+}
+
+// HandleFallback registers the handler for deliveries whose topic has no
+// handler registered.
+func (h *WebhookHandler) HandleFallback(handler WebhookHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = handler
+}
+
+// Use adds middlewares which are run, in order, around every handler.
+func (h *WebhookHandler) Use(middlewares ...WebhookMiddleware) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.middlewares = append(h.middlewares, middlewares...)
+}
+
+// HandleOrder registers a handler receiving the delivered Order. Without
+// topics, it handles every topic delivering an Order.
+func (h *WebhookHandler) HandleOrder(fn func(*WebhookDelivery, *Order) error, topics ...string) {
+	h.handlePayload((*Order)(nil), func(d *WebhookDelivery) error {
+		resource := new(Order)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleDraftOrder registers a handler receiving the delivered DraftOrder. Without
+// topics, it handles every topic delivering a DraftOrder.
+func (h *WebhookHandler) HandleDraftOrder(fn func(*WebhookDelivery, *DraftOrder) error, topics ...string) {
+	h.handlePayload((*DraftOrder)(nil), func(d *WebhookDelivery) error {
+		resource := new(DraftOrder)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleProduct registers a handler receiving the delivered Product. Without
+// topics, it handles every topic delivering a Product.
+func (h *WebhookHandler) HandleProduct(fn func(*WebhookDelivery, *Product) error, topics ...string) {
+	h.handlePayload((*Product)(nil), func(d *WebhookDelivery) error {
+		resource := new(Product)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleCollection registers a handler receiving the delivered Collection. Without
+// topics, it handles every topic delivering a Collection.
+func (h *WebhookHandler) HandleCollection(fn func(*WebhookDelivery, *Collection) error, topics ...string) {
+	h.handlePayload((*Collection)(nil), func(d *WebhookDelivery) error {
+		resource := new(Collection)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleCustomer registers a handler receiving the delivered Customer. Without
+// topics, it handles every topic delivering a Customer.
+func (h *WebhookHandler) HandleCustomer(fn func(*WebhookDelivery, *Customer) error, topics ...string) {
+	h.handlePayload((*Customer)(nil), func(d *WebhookDelivery) error {
+		resource := new(Customer)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleFulfillment registers a handler receiving the delivered Fulfillment. Without
+// topics, it handles every topic delivering a Fulfillment.
+func (h *WebhookHandler) HandleFulfillment(fn func(*WebhookDelivery, *Fulfillment) error, topics ...string) {
+	h.handlePayload((*Fulfillment)(nil), func(d *WebhookDelivery) error {
+		resource := new(Fulfillment)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleInventoryItem registers a handler receiving the delivered InventoryItem. Without
+// topics, it handles every topic delivering an InventoryItem.
+func (h *WebhookHandler) HandleInventoryItem(fn func(*WebhookDelivery, *InventoryItem) error, topics ...string) {
+	h.handlePayload((*InventoryItem)(nil), func(d *WebhookDelivery) error {
+		resource := new(InventoryItem)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleLocation registers a handler receiving the delivered Location. Without
+// topics, it handles every topic delivering a Location.
+func (h *WebhookHandler) HandleLocation(fn func(*WebhookDelivery, *Location) error, topics ...string) {
+	h.handlePayload((*Location)(nil), func(d *WebhookDelivery) error {
+		resource := new(Location)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleTransaction registers a handler receiving the delivered Transaction. Without
+// topics, it handles every topic delivering a Transaction.
+func (h *WebhookHandler) HandleTransaction(fn func(*WebhookDelivery, *Transaction) error, topics ...string) {
+	h.handlePayload((*Transaction)(nil), func(d *WebhookDelivery) error {
+		resource := new(Transaction)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleRefund registers a handler receiving the delivered Refund. Without
+// topics, it handles every topic delivering a Refund.
+func (h *WebhookHandler) HandleRefund(fn func(*WebhookDelivery, *Refund) error, topics ...string) {
+	h.handlePayload((*Refund)(nil), func(d *WebhookDelivery) error {
+		resource := new(Refund)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleShop registers a handler receiving the delivered Shop. Without
+// topics, it handles every topic delivering a Shop.
+func (h *WebhookHandler) HandleShop(fn func(*WebhookDelivery, *Shop) error, topics ...string) {
+	h.handlePayload((*Shop)(nil), func(d *WebhookDelivery) error {
+		resource := new(Shop)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// HandleTheme registers a handler receiving the delivered Theme. Without
+// topics, it handles every topic delivering a Theme.
+func (h *WebhookHandler) HandleTheme(fn func(*WebhookDelivery, *Theme) error, topics ...string) {
+	h.handlePayload((*Theme)(nil), func(d *WebhookDelivery) error {
+		resource := new(Theme)
+		if err := d.Decode(resource); err != nil {
+			return err
+		}
+		return fn(d, resource)
+	}, topics)
+}
+
+// Dispatch passes the delivery to the handler registered for its topic, or
+// to the typed handler for its payload, or to the fallback handler.
+func (h *WebhookHandler) Dispatch(delivery *WebhookDelivery) error {
+	h.mu.RLock()
+	handler, ok := h.handlers[delivery.Topic]
+	if !ok {
+		if payloadType, known := webhookPayloadType(delivery.Topic); known {
+			handler, ok = h.typed[payloadType]
+		}
+	}
+	if !ok {
+		handler = h.fallback
+	}
+	if handler == nil {
+		handler = func(*WebhookDelivery) error { return nil }
+	}
+	for i := len(h.middlewares) - 1; i >= 0; i-- {
+		handler = h.middlewares[i](handler)
+	}
+	h.mu.RUnlock()
+
+	return handler(delivery)
+}
+
+// ServeHTTP verifies and dispatches a webhook request. It answers with
+// 401 Unauthorized if the HMAC is invalid, 400 Bad Request if the body can't
+// be decoded or the delivery has expired, 500 Internal Server Error if the
+// handler returns an error and 200 OK otherwise.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if ok, _ := h.app.VerifyWebhookRequestVerbose(r); !ok {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	message, err := NewWebhookMessageFromRequest(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	err = h.HandleMessage(message)
+	if err != nil {
+		switch err.(type) {
+		case WebhookDecodingError, WebhookExpiredError:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,222 @@
+package goshopify
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newWebhookRequest builds a webhook request signed with the test app secret.
+func newWebhookRequest(topic, body string) *http.Request {
+	req := httptest.NewRequest("POST", "https://example.com/webhooks", bytes.NewBufferString(body))
+	req.Header.Set("X-Shopify-Hmac-Sha256", app.SignWebhookBody([]byte(body)))
+	req.Header.Set("X-Shopify-Topic", topic)
+	req.Header.Set("X-Shopify-Shop-Domain", "fooshop.myshopify.com")
+	req.Header.Set("X-Shopify-Webhook-Id", "b54557e4-bdd9-4b37-8a5f-bf7d70bcd043")
+	req.Header.Set("X-Shopify-API-Version", "2020-01")
+	req.Header.Set("X-Shopify-Triggered-At", "2020-01-02T03:04:05.123456Z")
+	return req
+}
+
+func TestNewWebhookDelivery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req := newWebhookRequest("orders/create", `{"id":1}`)
+	delivery := NewWebhookDelivery(req.Header, []byte(`{"id":1}`))
+
+	triggeredAt := time.Date(2020, time.January, 2, 3, 4, 5, 123456000, time.UTC)
+	if delivery.Topic != "orders/create" ||
+		delivery.ShopDomain != "fooshop.myshopify.com" ||
+		delivery.WebhookID != "b54557e4-bdd9-4b37-8a5f-bf7d70bcd043" ||
+		delivery.APIVersion != "2020-01" ||
+		delivery.TriggeredAt == nil || !delivery.TriggeredAt.Equal(triggeredAt) {
+		t.Errorf("NewWebhookDelivery returned %+v", delivery)
+	}
+
+	delivery = NewWebhookDelivery(http.Header{}, nil)
+	if delivery.TriggeredAt != nil {
+		t.Errorf("NewWebhookDelivery.TriggeredAt returned %v, expected nil", delivery.TriggeredAt)
+	}
+}
+
+func TestWebhookHandlerTypedDispatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var order *Order
+	var product *Product
+	handler := NewWebhookHandler(app)
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		order = o
+		return nil
+	}, WebhookTopicOrdersCreate, WebhookTopicOrdersUpdated)
+	handler.HandleProduct(func(d *WebhookDelivery, p *Product) error {
+		product = p
+		return nil
+	}, WebhookTopicProductsUpdate)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("orders/updated", `{"id":123456,"name":"#1001"}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+	if order == nil || order.ID != 123456 || order.Name != "#1001" {
+		t.Errorf("WebhookHandler.HandleOrder received %+v", order)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("products/update", `{"id":632910392,"title":"IPod Nano - 8GB"}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+	if product == nil || product.ID != 632910392 || product.Title != "IPod Nano - 8GB" {
+		t.Errorf("WebhookHandler.HandleProduct received %+v", product)
+	}
+}
+
+func TestNewWebhookPayload(t *testing.T) {
+	cases := []struct {
+		topic    string
+		expected interface{}
+	}{
+		{"orders/create", &Order{}},
+		{"orders/paid", &Order{}},
+		{"products/update", &Product{}},
+		{"customers/create", &Customer{}},
+		{"order_transactions/create", &Transaction{}},
+		{"customers/redact", &CustomerRedactRequest{}},
+		{"shop/redact", &ShopRedactRequest{}},
+		{"shop/update", &Shop{}},
+		{"carts/create", nil},
+		{"", nil},
+	}
+
+	for _, c := range cases {
+		payload := NewWebhookPayload(c.topic)
+		if !reflect.DeepEqual(payload, c.expected) {
+			t.Errorf("NewWebhookPayload(%q) returned %#v, expected %#v", c.topic, payload, c.expected)
+		}
+	}
+}
+
+func TestWebhookHandlerPayloadDispatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var orders []*Order
+	var cancelled *WebhookDelivery
+	var payload interface{}
+	handler := NewWebhookHandler(app)
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		orders = append(orders, o)
+		return nil
+	})
+	handler.Handle(func(d *WebhookDelivery) error {
+		cancelled = d
+		return nil
+	}, WebhookTopicOrdersCancelled)
+	handler.HandleFallback(func(d *WebhookDelivery) error {
+		var err error
+		payload, err = d.Payload()
+		return err
+	})
+
+	for _, topic := range []string{"orders/create", "orders/paid", "orders/cancelled"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newWebhookRequest(topic, `{"id":123456,"name":"#1001"}`))
+		if rec.Code != http.StatusOK {
+			t.Errorf("WebhookHandler returned status %d for %s, expected %d", rec.Code, topic, http.StatusOK)
+		}
+	}
+	if len(orders) != 2 || orders[0].ID != 123456 || orders[1].Name != "#1001" {
+		t.Errorf("WebhookHandler.HandleOrder received %+v", orders)
+	}
+	if cancelled == nil || cancelled.Topic != "orders/cancelled" {
+		t.Errorf("WebhookHandler.Handle received %+v", cancelled)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("products/update", `{"id":632910392,"title":"IPod Nano - 8GB"}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+	product, ok := payload.(*Product)
+	if !ok || product.ID != 632910392 || product.Title != "IPod Nano - 8GB" {
+		t.Errorf("WebhookDelivery.Payload returned %#v", payload)
+	}
+}
+
+func TestWebhookHandlerFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := NewWebhookHandler(app)
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		t.Error("WebhookHandler dispatched an unknown topic to the order handler")
+		return nil
+	}, WebhookTopicOrdersCreate)
+
+	// Without a fallback unknown topics are acknowledged
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("carts/create", `{"id":1}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	var delivery *WebhookDelivery
+	handler.HandleFallback(func(d *WebhookDelivery) error {
+		delivery = d
+		return nil
+	})
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("carts/create", `{"id":1}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+	if delivery == nil || delivery.Topic != "carts/create" || string(delivery.Body) != `{"id":1}` {
+		t.Errorf("WebhookHandler fallback received %+v", delivery)
+	}
+}
+
+func TestWebhookHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := NewWebhookHandler(app)
+	handler.HandleCustomer(func(d *WebhookDelivery, c *Customer) error {
+		return errors.New("test-error")
+	}, WebhookTopicCustomersCreate)
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		return nil
+	}, WebhookTopicOrdersCreate)
+
+	invalidHMAC := newWebhookRequest("orders/create", `{"id":1}`)
+	invalidHMAC.Header.Set("X-Shopify-Hmac-Sha256", "hMTq0K2x7oyOjoBwGYeTj5oxfnaVYXzbanUG9aajpKI=")
+
+	wrongMethod := newWebhookRequest("orders/create", `{"id":1}`)
+	wrongMethod.Method = "GET"
+
+	cases := []struct {
+		req      *http.Request
+		expected int
+	}{
+		{invalidHMAC, http.StatusUnauthorized},
+		{wrongMethod, http.StatusMethodNotAllowed},
+		{newWebhookRequest("orders/create", `{"id":"not a number"}`), http.StatusBadRequest},
+		{newWebhookRequest("customers/create", `{"id":1}`), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, c.expected)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package goshopify
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Shopify retries failed webhook deliveries for 48 hours.
+const defaultWebhookSeenTTL = 48 * time.Hour
+
+// memoryWebhookSeenPurgeInterval is how often MemoryWebhookSeenStore drops
+// expired keys
+const memoryWebhookSeenPurgeInterval = time.Minute
+
+// WebhookSeenStore records which webhook deliveries were already handled.
+// Implementations backed by a shared store (e.g. Redis) allow deduplicating
+// across several instances of an app.
+type WebhookSeenStore interface {
+	// MarkSeen records the key as seen for the given duration and reports
+	// whether it had already been seen.
+	MarkSeen(key string, ttl time.Duration) (bool, error)
+	// Forget removes the key so that a retried delivery is handled again.
+	Forget(key string) error
+}
+
+// MemoryWebhookSeenStore is an in-memory WebhookSeenStore, suitable for a
+// single app instance.
+type MemoryWebhookSeenStore struct {
+	mu        sync.Mutex
+	expires   map[string]time.Time
+	nextPurge time.Time
+
+	// used to expire keys, overridden in tests
+	now func() time.Time
+}
+
+// NewMemoryWebhookSeenStore returns an empty MemoryWebhookSeenStore.
+func NewMemoryWebhookSeenStore() *MemoryWebhookSeenStore {
+	return &MemoryWebhookSeenStore{
+		expires: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// MarkSeen records the key as seen for the given duration and reports whether
+// it had already been seen. Expired keys are purged along the way, at most
+// once a minute.
+func (s *MemoryWebhookSeenStore) MarkSeen(key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if !now.Before(s.nextPurge) {
+		s.purge(now)
+	}
+
+	if expires, seen := s.expires[key]; seen && now.Before(expires) {
+		return true, nil
+	}
+	s.expires[key] = now.Add(ttl)
+	return false, nil
+}
+
+// purge drops the keys expired at now, the caller must hold s.mu
+func (s *MemoryWebhookSeenStore) purge(now time.Time) {
+	for k, expires := range s.expires {
+		if !now.Before(expires) {
+			delete(s.expires, k)
+		}
+	}
+	s.nextPurge = now.Add(memoryWebhookSeenPurgeInterval)
+}
+
+// Forget removes the key from the store.
+func (s *MemoryWebhookSeenStore) Forget(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.expires, key)
+	return nil
+}
+
+// WebhookExpiredError occurs when a delivery was triggered longer ago than the
+// accepted window, which could mean it is being replayed. TriggeredAt is zero
+// if the delivery has no X-Shopify-Triggered-At, so its age is unknown.
+type WebhookExpiredError struct {
+	TriggeredAt time.Time
+	MaxAge      time.Duration
+}
+
+func (e WebhookExpiredError) Error() string {
+	if e.TriggeredAt.IsZero() {
+		return fmt.Sprintf("webhook has no triggered at time to check against %s", e.MaxAge)
+	}
+	return fmt.Sprintf("webhook triggered at %s is older than %s", e.TriggeredAt.Format(time.RFC3339), e.MaxAge)
+}
+
+// WebhookForgetError occurs when a delivery the handler failed on could not be
+// forgotten, so Shopify's retry of it will be skipped as a duplicate.
+type WebhookForgetError struct {
+	Key        string
+	HandlerErr error
+	Err        error
+}
+
+func (e WebhookForgetError) Error() string {
+	return fmt.Sprintf("%v; forgetting webhook delivery %s failed: %v", e.HandlerErr, e.Key, e.Err)
+}
+
+// WebhookDeduplicator makes webhook handling idempotent by skipping deliveries
+// that were already handled, and rejects deliveries triggered too long ago.
+type WebhookDeduplicator struct {
+	// Store records the handled deliveries.
+	Store WebhookSeenStore
+
+	// TTL is how long a delivery is remembered, defaults to 48 hours which is
+	// how long Shopify keeps retrying.
+	TTL time.Duration
+
+	// MaxAge rejects deliveries whose X-Shopify-Triggered-At is older than
+	// this, or missing. Zero accepts deliveries of any age.
+	MaxAge time.Duration
+
+	// Key returns the key used to identify duplicate deliveries, defaults to
+	// WebhookDeliveryKey. Use WebhookEventKey to also deduplicate an event
+	// delivered to several subscriptions.
+	Key func(*WebhookDelivery) string
+
+	// used to check MaxAge, overridden in tests
+	now func() time.Time
+}
+
+// NewWebhookDeduplicator returns a WebhookDeduplicator backed by the store
+// with the default TTL and no MaxAge.
+func NewWebhookDeduplicator(store WebhookSeenStore) *WebhookDeduplicator {
+	return &WebhookDeduplicator{Store: store}
+}
+
+// WebhookDeliveryKey identifies a delivery by its X-Shopify-Webhook-Id,
+// falling back to the X-Shopify-Event-Id.
+func WebhookDeliveryKey(d *WebhookDelivery) string {
+	if d.WebhookID != "" {
+		return d.WebhookID
+	}
+	return d.EventID
+}
+
+// WebhookEventKey identifies a delivery by its X-Shopify-Event-Id, falling
+// back to the X-Shopify-Webhook-Id.
+func WebhookEventKey(d *WebhookDelivery) string {
+	if d.EventID != "" {
+		return d.EventID
+	}
+	return d.WebhookID
+}
+
+// Middleware returns a WebhookMiddleware, to be passed to WebhookHandler.Use.
+//
+// Deliveries that were already handled are acknowledged without calling the
+// next handler. If the next handler fails the delivery is forgotten again so
+// that Shopify's retry is handled; if that fails a WebhookForgetError is
+// returned. Expired deliveries return a WebhookExpiredError.
+func (dd *WebhookDeduplicator) Middleware() WebhookMiddleware {
+	return func(next WebhookHandlerFunc) WebhookHandlerFunc {
+		return func(d *WebhookDelivery) error {
+			if err := dd.checkAge(d); err != nil {
+				return err
+			}
+
+			key := dd.key(d)
+			if key == "" {
+				// nothing to deduplicate on
+				return next(d)
+			}
+
+			seen, err := dd.Store.MarkSeen(key, dd.ttl())
+			if err != nil {
+				return err
+			}
+			if seen {
+				return nil
+			}
+
+			err = next(d)
+			if err != nil {
+				if forgetErr := dd.Store.Forget(key); forgetErr != nil {
+					return WebhookForgetError{Key: key, HandlerErr: err, Err: forgetErr}
+				}
+			}
+			return err
+		}
+	}
+}
+
+func (dd *WebhookDeduplicator) checkAge(d *WebhookDelivery) error {
+	if dd.MaxAge <= 0 {
+		return nil
+	}
+	if d.TriggeredAt == nil {
+		return WebhookExpiredError{MaxAge: dd.MaxAge}
+	}
+
+	now := time.Now
+	if dd.now != nil {
+		now = dd.now
+	}
+
+	if now().Sub(*d.TriggeredAt) > dd.MaxAge {
+		return WebhookExpiredError{TriggeredAt: *d.TriggeredAt, MaxAge: dd.MaxAge}
+	}
+	return nil
+}
+
+func (dd *WebhookDeduplicator) key(d *WebhookDelivery) string {
+	if dd.Key != nil {
+		return dd.Key(d)
+	}
+	return WebhookDeliveryKey(d)
+}
+
+func (dd *WebhookDeduplicator) ttl() time.Duration {
+	if dd.TTL > 0 {
+		return dd.TTL
+	}
+	return defaultWebhookSeenTTL
+}
@@ -0,0 +1,201 @@
+package goshopify
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryWebhookSeenStore(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryWebhookSeenStore()
+	store.now = func() time.Time { return now }
+
+	if seen, _ := store.MarkSeen("a", time.Hour); seen {
+		t.Error("MemoryWebhookSeenStore.MarkSeen reported an unknown key as seen")
+	}
+	if seen, _ := store.MarkSeen("a", time.Hour); !seen {
+		t.Error("MemoryWebhookSeenStore.MarkSeen did not report a known key as seen")
+	}
+
+	store.Forget("a")
+	if seen, _ := store.MarkSeen("a", time.Hour); seen {
+		t.Error("MemoryWebhookSeenStore.MarkSeen reported a forgotten key as seen")
+	}
+
+	now = now.Add(time.Hour)
+	if seen, _ := store.MarkSeen("a", time.Hour); seen {
+		t.Error("MemoryWebhookSeenStore.MarkSeen reported an expired key as seen")
+	}
+}
+
+func TestMemoryWebhookSeenStorePurge(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryWebhookSeenStore()
+	store.now = func() time.Time { return now }
+
+	store.MarkSeen("a", time.Second)
+	now = now.Add(2 * time.Second)
+	store.MarkSeen("b", time.Hour)
+	if len(store.expires) != 2 {
+		t.Errorf("MemoryWebhookSeenStore purged before the purge interval: %v", store.expires)
+	}
+
+	now = now.Add(memoryWebhookSeenPurgeInterval)
+	store.MarkSeen("c", time.Hour)
+	if _, ok := store.expires["a"]; ok || len(store.expires) != 2 {
+		t.Errorf("MemoryWebhookSeenStore did not purge expired keys: %v", store.expires)
+	}
+}
+
+type failingForgetStore struct {
+	*MemoryWebhookSeenStore
+}
+
+func (s failingForgetStore) Forget(key string) error {
+	return errors.New("forget-error")
+}
+
+func TestWebhookDeduplicatorForgetError(t *testing.T) {
+	handlerErr := errors.New("test-error")
+	handle := NewWebhookDeduplicator(failingForgetStore{NewMemoryWebhookSeenStore()}).Middleware()(func(d *WebhookDelivery) error {
+		return handlerErr
+	})
+
+	err := handle(&WebhookDelivery{WebhookID: "1"})
+	forgetErr, ok := err.(WebhookForgetError)
+	if !ok || forgetErr.Key != "1" || forgetErr.HandlerErr != handlerErr || forgetErr.Err.Error() != "forget-error" {
+		t.Errorf("WebhookDeduplicator returned %#v, expected a WebhookForgetError", err)
+	}
+
+	expectedMessage := "test-error; forgetting webhook delivery 1 failed: forget-error"
+	if err != nil && err.Error() != expectedMessage {
+		t.Errorf("WebhookForgetError.Error returned %s, expected %s", err.Error(), expectedMessage)
+	}
+}
+
+func TestWebhookDeduplicatorSkipsDuplicates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	fail := true
+	handler := NewWebhookHandler(app)
+	handler.Use(NewWebhookDeduplicator(NewMemoryWebhookSeenStore()).Middleware())
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		calls++
+		if fail {
+			return errors.New("test-error")
+		}
+		return nil
+	}, WebhookTopicOrdersCreate)
+
+	cases := []struct {
+		fail          bool
+		expectedCode  int
+		expectedCalls int
+	}{
+		// a failed delivery is retried
+		{true, http.StatusInternalServerError, 1},
+		{false, http.StatusOK, 2},
+		// a handled delivery is acknowledged without calling the handler
+		{false, http.StatusOK, 2},
+	}
+
+	for _, c := range cases {
+		fail = c.fail
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newWebhookRequest("orders/create", `{"id":1}`))
+		if rec.Code != c.expectedCode {
+			t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, c.expectedCode)
+		}
+		if calls != c.expectedCalls {
+			t.Errorf("WebhookHandler called handler %d times, expected %d", calls, c.expectedCalls)
+		}
+	}
+}
+
+func TestWebhookDeduplicatorKeys(t *testing.T) {
+	cases := []struct {
+		key      func(*WebhookDelivery) string
+		delivery WebhookDelivery
+		expected string
+	}{
+		{WebhookDeliveryKey, WebhookDelivery{WebhookID: "w", EventID: "e"}, "w"},
+		{WebhookDeliveryKey, WebhookDelivery{EventID: "e"}, "e"},
+		{WebhookEventKey, WebhookDelivery{WebhookID: "w", EventID: "e"}, "e"},
+		{WebhookEventKey, WebhookDelivery{WebhookID: "w"}, "w"},
+	}
+
+	for _, c := range cases {
+		if actual := c.key(&c.delivery); actual != c.expected {
+			t.Errorf("key(%+v) returned %s, expected %s", c.delivery, actual, c.expected)
+		}
+	}
+}
+
+func TestWebhookDeduplicatorMaxAge(t *testing.T) {
+	triggeredAt := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	dedup := NewWebhookDeduplicator(NewMemoryWebhookSeenStore())
+	dedup.MaxAge = time.Hour
+
+	calls := 0
+	handle := dedup.Middleware()(func(d *WebhookDelivery) error {
+		calls++
+		return nil
+	})
+
+	dedup.now = func() time.Time { return triggeredAt.Add(time.Minute) }
+	err := handle(&WebhookDelivery{WebhookID: "1", TriggeredAt: &triggeredAt})
+	if err != nil || calls != 1 {
+		t.Errorf("WebhookDeduplicator rejected a recent delivery: %v", err)
+	}
+
+	dedup.now = func() time.Time { return triggeredAt.Add(2 * time.Hour) }
+	err = handle(&WebhookDelivery{WebhookID: "2", TriggeredAt: &triggeredAt})
+	expected := WebhookExpiredError{TriggeredAt: triggeredAt, MaxAge: time.Hour}
+	if err != expected || calls != 1 {
+		t.Errorf("WebhookDeduplicator returned %v, expected %v", err, expected)
+	}
+}
+
+func TestWebhookDeduplicatorMaxAgeWithoutTriggeredAt(t *testing.T) {
+	calls := 0
+	next := func(d *WebhookDelivery) error {
+		calls++
+		return nil
+	}
+
+	dedup := NewWebhookDeduplicator(NewMemoryWebhookSeenStore())
+	err := dedup.Middleware()(next)(&WebhookDelivery{WebhookID: "1"})
+	if err != nil || calls != 1 {
+		t.Errorf("WebhookDeduplicator without MaxAge rejected a delivery: %v", err)
+	}
+
+	dedup.MaxAge = time.Hour
+	err = dedup.Middleware()(next)(&WebhookDelivery{WebhookID: "2"})
+	expected := WebhookExpiredError{MaxAge: time.Hour}
+	if err != expected || calls != 1 {
+		t.Errorf("WebhookDeduplicator returned %v, expected %v", err, expected)
+	}
+}
+
+func TestWebhookHandlerExpiredDelivery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dedup := NewWebhookDeduplicator(NewMemoryWebhookSeenStore())
+	dedup.MaxAge = time.Hour
+
+	handler := NewWebhookHandler(app)
+	handler.Use(dedup.Middleware())
+
+	// the test request was triggered in 2020
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("orders/create", `{"id":1}`))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("WebhookHandler returned status %d, expected %d", rec.Code, http.StatusBadRequest)
+	}
+}
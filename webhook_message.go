@@ -0,0 +1,182 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// WebhookMessage is a webhook as received from any transport: the Shopify
+// headers and the raw JSON body. It is built from an HTTP request, or from the
+// envelope of a message delivered through Amazon EventBridge or Google Cloud
+// Pub/Sub.
+type WebhookMessage struct {
+	Header http.Header
+	Body   []byte
+}
+
+// NewWebhookMessageFromRequest reads the headers and body of a webhook http
+// request. The body of the request is still readable afterwards.
+func NewWebhookMessageFromRequest(httpRequest *http.Request) (*WebhookMessage, error) {
+	body, err := ioutil.ReadAll(httpRequest.Body)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	return &WebhookMessage{Header: httpRequest.Header, Body: body}, nil
+}
+
+// Delivery returns the WebhookDelivery of the message.
+func (m *WebhookMessage) Delivery() *WebhookDelivery {
+	return NewWebhookDelivery(m.Header, m.Body)
+}
+
+// webhookEnvelope covers the envelopes webhooks are wrapped in on event buses.
+type webhookEnvelope struct {
+	// Amazon EventBridge
+	Detail *struct {
+		Metadata map[string]string `json:"metadata"`
+		Payload  json.RawMessage   `json:"payload"`
+	} `json:"detail"`
+
+	// Google Cloud Pub/Sub push subscription
+	Message *struct {
+		Attributes map[string]string `json:"attributes"`
+		Data       string            `json:"data"`
+	} `json:"message"`
+
+	// Plain headers and body, the body either as JSON or as a JSON string
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+// DecodeWebhookEnvelope decodes a webhook wrapped in a JSON message envelope.
+// It understands Amazon EventBridge events ({"detail": {"metadata": {...},
+// "payload": {...}}}), Google Cloud Pub/Sub push messages ({"message":
+// {"attributes": {...}, "data": "base64"}}) and plain {"headers": {...},
+// "body": ...} envelopes.
+func DecodeWebhookEnvelope(data []byte) (*WebhookMessage, error) {
+	envelope := new(webhookEnvelope)
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+
+	var headers map[string]string
+	var body []byte
+	switch {
+	case envelope.Detail != nil:
+		headers, body = envelope.Detail.Metadata, envelope.Detail.Payload
+	case envelope.Message != nil:
+		decoded, err := base64.StdEncoding.DecodeString(envelope.Message.Data)
+		if err != nil {
+			return nil, err
+		}
+		headers, body = envelope.Message.Attributes, decoded
+	case envelope.Headers != nil:
+		headers, body = envelope.Headers, envelope.Body
+		var s string
+		if json.Unmarshal(body, &s) == nil {
+			body = []byte(s)
+		}
+	default:
+		return nil, errors.New("unknown webhook envelope")
+	}
+
+	message := &WebhookMessage{Header: http.Header{}, Body: body}
+	for name, value := range headers {
+		message.Header.Set(name, value)
+	}
+
+	if message.Header.Get(webhookTopicHeader) == "" {
+		return nil, errors.New("webhook envelope has no topic")
+	}
+
+	return message, nil
+}
+
+// Verifies the HMAC of a webhook message, for transports that pass on the
+// X-Shopify-Hmac-Sha256 header.
+func (app App) VerifyWebhookMessage(message *WebhookMessage) bool {
+	actualMac := []byte(message.Header.Get(shopifyChecksumHeader))
+	expectedMac := []byte(app.SignWebhookBody(message.Body))
+	return len(actualMac) > 0 && hmac.Equal(actualMac, expectedMac)
+}
+
+// HandleMessage dispatches the message to the handler registered for its
+// topic. Unlike ServeHTTP it doesn't verify the HMAC, event bus deliveries
+// are authenticated by the bus. Use App.VerifyWebhookMessage where needed.
+func (h *WebhookHandler) HandleMessage(message *WebhookMessage) error {
+	return h.Dispatch(message.Delivery())
+}
+
+// WebhookSource is a source of webhook messages, e.g. a queue subscription.
+type WebhookSource interface {
+	// Receive blocks until a message is available. It returns io.EOF once the
+	// source is exhausted.
+	Receive(ctx context.Context) (*WebhookMessage, error)
+	// Complete reports the result of handling the message so that the source
+	// can acknowledge it, or have it redelivered when err is not nil.
+	Complete(message *WebhookMessage, err error) error
+}
+
+// Consume handles the messages of the source until it is exhausted or the
+// context is done.
+func (h *WebhookHandler) Consume(ctx context.Context, source WebhookSource) error {
+	for {
+		message, err := source.Receive(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := source.Complete(message, h.HandleMessage(message)); err != nil {
+			return err
+		}
+	}
+}
+
+// ChannelWebhookSource is a WebhookSource reading from a channel, useful for
+// tests and in-process queues. The source is exhausted once the channel is
+// closed.
+type ChannelWebhookSource struct {
+	messages <-chan *WebhookMessage
+
+	// OnComplete, if set, is called with the result of every handled message.
+	OnComplete func(*WebhookMessage, error)
+}
+
+// NewChannelWebhookSource returns a ChannelWebhookSource reading from the
+// channel.
+func NewChannelWebhookSource(messages <-chan *WebhookMessage) *ChannelWebhookSource {
+	return &ChannelWebhookSource{messages: messages}
+}
+
+// Receive returns the next message of the channel.
+func (s *ChannelWebhookSource) Receive(ctx context.Context) (*WebhookMessage, error) {
+	select {
+	case message, ok := <-s.messages:
+		if !ok {
+			return nil, io.EOF
+		}
+		return message, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Complete calls OnComplete.
+func (s *ChannelWebhookSource) Complete(message *WebhookMessage, err error) error {
+	if s.OnComplete != nil {
+		s.OnComplete(message, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestDecodeWebhookEnvelope(t *testing.T) {
+	pubSubData := base64.StdEncoding.EncodeToString([]byte(`{"id":123456}`))
+
+	cases := []struct {
+		name     string
+		envelope string
+	}{
+		{"eventbridge", `{"version":"0","detail-type":"shopifyWebhook","source":"aws.partner/shopify.com/1/fooshop","detail":{"payload":{"id":123456},"metadata":{"Content-Type":"application/json","X-Shopify-Topic":"orders/create","X-Shopify-Hmac-SHA256":"hmac","X-Shopify-Shop-Domain":"fooshop.myshopify.com","X-Shopify-Webhook-Id":"b54557e4","X-Shopify-API-Version":"2020-01"}}}`},
+		{"pubsub", `{"message":{"attributes":{"X-Shopify-Topic":"orders/create","X-Shopify-Hmac-SHA256":"hmac","X-Shopify-Shop-Domain":"fooshop.myshopify.com","X-Shopify-Webhook-Id":"b54557e4","X-Shopify-API-Version":"2020-01"},"data":"` + pubSubData + `","messageId":"1"},"subscription":"projects/foo/subscriptions/bar"}`},
+		{"headers and body", `{"headers":{"X-Shopify-Topic":"orders/create","X-Shopify-Hmac-Sha256":"hmac","X-Shopify-Shop-Domain":"fooshop.myshopify.com","X-Shopify-Webhook-Id":"b54557e4","X-Shopify-Api-Version":"2020-01"},"body":{"id":123456}}`},
+		{"headers and string body", `{"headers":{"X-Shopify-Topic":"orders/create","X-Shopify-Hmac-Sha256":"hmac","X-Shopify-Shop-Domain":"fooshop.myshopify.com","X-Shopify-Webhook-Id":"b54557e4","X-Shopify-Api-Version":"2020-01"},"body":"{\"id\":123456}"}`},
+	}
+
+	for _, c := range cases {
+		message, err := DecodeWebhookEnvelope([]byte(c.envelope))
+		if err != nil {
+			t.Errorf("DecodeWebhookEnvelope(%s) returned error: %v", c.name, err)
+			continue
+		}
+
+		if message.Header.Get("X-Shopify-Hmac-Sha256") != "hmac" {
+			t.Errorf("DecodeWebhookEnvelope(%s) returned headers %v", c.name, message.Header)
+		}
+
+		delivery := message.Delivery()
+		if delivery.Topic != "orders/create" || delivery.ShopDomain != "fooshop.myshopify.com" ||
+			delivery.WebhookID != "b54557e4" || delivery.APIVersion != "2020-01" || string(delivery.Body) != `{"id":123456}` {
+			t.Errorf("DecodeWebhookEnvelope(%s) returned delivery %+v", c.name, delivery)
+		}
+	}
+}
+
+func TestDecodeWebhookEnvelopeErrors(t *testing.T) {
+	cases := []struct {
+		envelope      string
+		expectedError string
+	}{
+		{`{"foo":"bar"}`, "unknown webhook envelope"},
+		{`{"headers":{"X-Shopify-Shop-Domain":"fooshop.myshopify.com"},"body":{}}`, "webhook envelope has no topic"},
+		{`{"message":{"attributes":{"X-Shopify-Topic":"orders/create"},"data":"!!"}}`, "illegal base64 data at input byte 0"},
+	}
+
+	for _, c := range cases {
+		_, err := DecodeWebhookEnvelope([]byte(c.envelope))
+		if err == nil || err.Error() != c.expectedError {
+			t.Errorf("DecodeWebhookEnvelope(%s) returned error %v, expected %s", c.envelope, err, c.expectedError)
+		}
+	}
+}
+
+func TestVerifyWebhookMessage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	message, err := NewWebhookMessageFromRequest(newWebhookRequest("orders/create", `{"id":1}`))
+	if err != nil {
+		t.Fatalf("NewWebhookMessageFromRequest returned error: %v", err)
+	}
+	if !app.VerifyWebhookMessage(message) {
+		t.Error("App.VerifyWebhookMessage returned false for a signed message")
+	}
+
+	message.Body = []byte(`{"id":2}`)
+	if app.VerifyWebhookMessage(message) {
+		t.Error("App.VerifyWebhookMessage returned true for a tampered message")
+	}
+}
+
+func TestWebhookHandlerConsume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var orderIDs []int64
+	handler := NewWebhookHandler(app)
+	handler.HandleOrder(func(d *WebhookDelivery, o *Order) error {
+		orderIDs = append(orderIDs, o.ID)
+		if o.ID == 2 {
+			return errors.New("test-error")
+		}
+		return nil
+	}, WebhookTopicOrdersCreate)
+
+	messages := make(chan *WebhookMessage, 2)
+	for _, body := range []string{`{"id":1}`, `{"id":2}`} {
+		message, _ := DecodeWebhookEnvelope([]byte(`{"headers":{"X-Shopify-Topic":"orders/create"},"body":` + body + `}`))
+		messages <- message
+	}
+	close(messages)
+
+	var results []error
+	source := NewChannelWebhookSource(messages)
+	source.OnComplete = func(m *WebhookMessage, err error) {
+		results = append(results, err)
+	}
+
+	err := handler.Consume(context.Background(), source)
+	if err != nil {
+		t.Fatalf("WebhookHandler.Consume returned error: %v", err)
+	}
+
+	if len(orderIDs) != 2 || orderIDs[0] != 1 || orderIDs[1] != 2 {
+		t.Errorf("WebhookHandler.Consume handled orders %v, expected [1 2]", orderIDs)
+	}
+	if len(results) != 2 || results[0] != nil || results[1] == nil || results[1].Error() != "test-error" {
+		t.Errorf("ChannelWebhookSource completed with %v, expected [<nil> test-error]", results)
+	}
+}
+
+func TestWebhookHandlerConsumeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := NewWebhookHandler(App{}).Consume(ctx, NewChannelWebhookSource(make(chan *WebhookMessage)))
+	if err != context.Canceled {
+		t.Errorf("WebhookHandler.Consume returned %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestWebhookAddressKind(t *testing.T) {
+	cases := []struct {
+		address  string
+		expected string
+	}{
+		{"https://example.com/webhooks", WebhookAddressHTTP},
+		{"arn:aws:events:us-east-1::event-source/aws.partner/shopify.com/1/fooshop", WebhookAddressEventBridge},
+		{"pubsub://my-project:shopify-webhooks", WebhookAddressPubSub},
+	}
+
+	for _, c := range cases {
+		actual := Webhook{Address: c.address}.AddressKind()
+		if actual != c.expected {
+			t.Errorf("Webhook{Address: %s}.AddressKind() returned %s, expected %s", c.address, actual, c.expected)
+		}
+	}
+}
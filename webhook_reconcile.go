@@ -0,0 +1,207 @@
+package goshopify
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const defaultWebhookFormat = "json"
+
+// webhookListPageSize is the number of webhooks fetched per request by
+// PlanReconcile, the maximum Shopify allows
+const webhookListPageSize = 250
+
+// Operations of a WebhookOperationError
+const (
+	WebhookOperationCreate = "create"
+	WebhookOperationUpdate = "update"
+	WebhookOperationDelete = "delete"
+)
+
+// WebhookPlan lists the changes needed to converge a shop's webhook
+// subscriptions to a desired set.
+type WebhookPlan struct {
+	// Webhooks to create
+	Create []Webhook
+	// Webhooks to update, with the ID of the existing subscription
+	Update []Webhook
+	// Existing webhooks to delete
+	Delete []Webhook
+	// Existing webhooks that already match
+	Unchanged []Webhook
+}
+
+// Empty reports whether the plan has no changes to apply.
+func (p *WebhookPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// PlanWebhookReconcile computes the plan to go from the existing webhooks to
+// the desired ones. Webhooks are matched on topic and address, a matching
+// webhook is updated when its format, fields or metafield namespaces differ.
+func PlanWebhookReconcile(existing, desired []Webhook) (*WebhookPlan, error) {
+	existingByKey := make(map[string]Webhook, len(existing))
+	for _, webhook := range existing {
+		existingByKey[webhookReconcileKey(webhook)] = webhook
+	}
+
+	plan := new(WebhookPlan)
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, webhook := range desired {
+		key := webhookReconcileKey(webhook)
+		if desiredKeys[key] {
+			return nil, fmt.Errorf("webhook for topic %s and address %s is desired more than once", webhook.Topic, webhook.Address)
+		}
+		desiredKeys[key] = true
+
+		current, ok := existingByKey[key]
+		switch {
+		case !ok:
+			webhook.ID = 0
+			plan.Create = append(plan.Create, webhook)
+		case webhookMatches(current, webhook):
+			plan.Unchanged = append(plan.Unchanged, current)
+		default:
+			webhook.ID = current.ID
+			plan.Update = append(plan.Update, webhook)
+		}
+	}
+
+	for _, webhook := range existing {
+		if !desiredKeys[webhookReconcileKey(webhook)] {
+			plan.Delete = append(plan.Delete, webhook)
+		}
+	}
+
+	return plan, nil
+}
+
+func webhookReconcileKey(webhook Webhook) string {
+	return webhook.Topic + " " + webhook.Address
+}
+
+func webhookMatches(current, desired Webhook) bool {
+	return webhookFormat(current) == webhookFormat(desired) &&
+		sameStrings(current.Fields, desired.Fields) &&
+		sameStrings(current.MetafieldNamespaces, desired.MetafieldNamespaces)
+}
+
+func webhookFormat(webhook Webhook) string {
+	if webhook.Format == "" {
+		return defaultWebhookFormat
+	}
+	return webhook.Format
+}
+
+// sameStrings reports whether a and b hold the same strings, in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// WebhookOperationError is a change of a WebhookPlan that failed to apply.
+type WebhookOperationError struct {
+	// Operation is WebhookOperationCreate, WebhookOperationUpdate or
+	// WebhookOperationDelete
+	Operation string
+	Webhook   Webhook
+	Err       error
+}
+
+func (e WebhookOperationError) Error() string {
+	return fmt.Sprintf("%s webhook for topic %s and address %s: %v", e.Operation, e.Webhook.Topic, e.Webhook.Address, e.Err)
+}
+
+// WebhookPlanError occurs when some changes of a WebhookPlan failed to apply.
+// All the changes not listed in it were applied.
+type WebhookPlanError struct {
+	Errors []WebhookOperationError
+}
+
+func (e WebhookPlanError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d webhook changes failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// PlanReconcile lists all the shop's webhooks and returns the plan to converge
+// them to the desired webhooks, without applying it.
+func (s *WebhookServiceOp) PlanReconcile(desired []Webhook) (*WebhookPlan, error) {
+	var existing []Webhook
+	var options interface{} = ListOptions{Limit: webhookListPageSize}
+	for {
+		webhooks, pagination, err := s.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, webhooks...)
+
+		if pagination == nil || pagination.NextPageOptions == nil {
+			break
+		}
+		options = pagination.NextPageOptions
+	}
+	return PlanWebhookReconcile(existing, desired)
+}
+
+// ApplyPlan creates, updates and deletes webhooks according to the plan.
+// The created and updated webhooks in the plan are replaced with the ones
+// returned by Shopify. A failed change doesn't stop the others, the failures
+// are returned in a WebhookPlanError.
+func (s *WebhookServiceOp) ApplyPlan(plan *WebhookPlan) error {
+	var planErr WebhookPlanError
+
+	for i, webhook := range plan.Create {
+		created, err := s.Create(webhook)
+		if err != nil {
+			planErr.Errors = append(planErr.Errors, WebhookOperationError{WebhookOperationCreate, webhook, err})
+			continue
+		}
+		plan.Create[i] = *created
+	}
+
+	for i, webhook := range plan.Update {
+		updated, err := s.Update(webhook)
+		if err != nil {
+			planErr.Errors = append(planErr.Errors, WebhookOperationError{WebhookOperationUpdate, webhook, err})
+			continue
+		}
+		plan.Update[i] = *updated
+	}
+
+	for _, webhook := range plan.Delete {
+		err := s.Delete(webhook.ID)
+		if err != nil {
+			planErr.Errors = append(planErr.Errors, WebhookOperationError{WebhookOperationDelete, webhook, err})
+		}
+	}
+
+	if len(planErr.Errors) > 0 {
+		return planErr
+	}
+	return nil
+}
+
+// Reconcile converges the shop's webhooks to the desired webhooks and returns
+// the applied plan. If some changes failed the error is a WebhookPlanError.
+func (s *WebhookServiceOp) Reconcile(desired []Webhook) (*WebhookPlan, error) {
+	plan, err := s.PlanReconcile(desired)
+	if err != nil {
+		return nil, err
+	}
+	return plan, s.ApplyPlan(plan)
+}
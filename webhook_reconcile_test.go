@@ -0,0 +1,188 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestPlanWebhookReconcile(t *testing.T) {
+	existing := []Webhook{
+		{ID: 1, Topic: "orders/create", Address: "https://example.com/orders", Format: "json", Fields: []string{"id", "name"}},
+		{ID: 2, Topic: "products/update", Address: "https://example.com/products", Format: "json"},
+		{ID: 3, Topic: "app/uninstalled", Address: "https://example.com/old", Format: "json"},
+	}
+	desired := []Webhook{
+		{Topic: "orders/create", Address: "https://example.com/orders", Fields: []string{"name", "id"}},
+		{Topic: "products/update", Address: "https://example.com/products", MetafieldNamespaces: []string{"inventory"}},
+		{Topic: "app/uninstalled", Address: "https://example.com/uninstalled"},
+	}
+
+	plan, err := PlanWebhookReconcile(existing, desired)
+	if err != nil {
+		t.Fatalf("PlanWebhookReconcile returned error: %v", err)
+	}
+
+	expected := &WebhookPlan{
+		Create: []Webhook{
+			{Topic: "app/uninstalled", Address: "https://example.com/uninstalled"},
+		},
+		Update: []Webhook{
+			{ID: 2, Topic: "products/update", Address: "https://example.com/products", MetafieldNamespaces: []string{"inventory"}},
+		},
+		Delete: []Webhook{
+			{ID: 3, Topic: "app/uninstalled", Address: "https://example.com/old", Format: "json"},
+		},
+		Unchanged: []Webhook{
+			{ID: 1, Topic: "orders/create", Address: "https://example.com/orders", Format: "json", Fields: []string{"id", "name"}},
+		},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("PlanWebhookReconcile returned %+v, expected %+v", plan, expected)
+	}
+	if plan.Empty() {
+		t.Error("WebhookPlan.Empty returned true, expected false")
+	}
+
+	plan, _ = PlanWebhookReconcile(existing[:1], desired[:1])
+	if !plan.Empty() {
+		t.Errorf("WebhookPlan.Empty returned false for %+v, expected true", plan)
+	}
+}
+
+func TestPlanWebhookReconcileDuplicate(t *testing.T) {
+	desired := []Webhook{
+		{Topic: "orders/create", Address: "https://example.com/orders"},
+		{Topic: "orders/create", Address: "https://example.com/orders", Format: "xml"},
+	}
+
+	_, err := PlanWebhookReconcile(nil, desired)
+	expected := "webhook for topic orders/create and address https://example.com/orders is desired more than once"
+	if err == nil || err.Error() != expected {
+		t.Errorf("PlanWebhookReconcile returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestWebhookReconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		map[string]string{"limit": "250"},
+		httpmock.NewBytesResponder(200, loadFixture("webhooks.json")))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"webhook":{"id":4759307,"topic":"products/update","address":"https://example.com/products","format":"json"}}`))
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"webhook":{"id":4759306,"topic":"orders/create","address":"http://apple.com","format":"json","fields":["id"]}}`))
+
+	desired := []Webhook{
+		{Topic: "orders/create", Address: "http://apple.com", Fields: []string{"id"}},
+		{Topic: "products/update", Address: "https://example.com/products"},
+	}
+
+	plan, err := client.Webhook.PlanReconcile(desired)
+	if err != nil {
+		t.Fatalf("Webhook.PlanReconcile returned error: %v", err)
+	}
+	info := httpmock.GetCallCountInfo()
+	if info["POST "+fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix)] != 0 {
+		t.Error("Webhook.PlanReconcile created a webhook")
+	}
+	if len(plan.Create) != 1 || len(plan.Update) != 1 || len(plan.Delete) != 0 {
+		t.Errorf("Webhook.PlanReconcile returned %+v", plan)
+	}
+
+	plan, err = client.Webhook.Reconcile(desired)
+	if err != nil {
+		t.Fatalf("Webhook.Reconcile returned error: %v", err)
+	}
+	if plan.Create[0].ID != 4759307 {
+		t.Errorf("Webhook.Reconcile created webhook %+v, expected ID %d", plan.Create[0], 4759307)
+	}
+	if !reflect.DeepEqual(plan.Update[0].Fields, []string{"id"}) {
+		t.Errorf("Webhook.Reconcile updated webhook %+v, expected fields %v", plan.Update[0], []string{"id"})
+	}
+}
+
+func TestWebhookReconcileDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("webhooks.json")))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	plan, err := client.Webhook.Reconcile(nil)
+	if err != nil {
+		t.Fatalf("Webhook.Reconcile returned error: %v", err)
+	}
+	if len(plan.Delete) != 1 || plan.Delete[0].ID != 4759306 {
+		t.Errorf("Webhook.Reconcile returned %+v, expected to delete webhook %d", plan, 4759306)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if info["DELETE "+fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix)] != 1 {
+		t.Error("Webhook.Reconcile did not delete the webhook")
+	}
+}
+
+func TestWebhookPlanReconcilePages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		map[string]string{"limit": "250"},
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("webhooks.json"))
+			resp.Header.Set("Link", `<http://valid.url?page_info=p2&limit=250>; rel="next"`)
+			return resp, nil
+		})
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		map[string]string{"limit": "250", "page_info": "p2"},
+		httpmock.NewStringResponder(200, `{"webhooks":[{"id":4759308,"topic":"products/update","address":"https://example.com/products","format":"json"}]}`))
+
+	plan, err := client.Webhook.PlanReconcile([]Webhook{{Topic: "products/update", Address: "https://example.com/products"}})
+	if err != nil {
+		t.Fatalf("Webhook.PlanReconcile returned error: %v", err)
+	}
+	if len(plan.Unchanged) != 1 || plan.Unchanged[0].ID != 4759308 {
+		t.Errorf("Webhook.PlanReconcile returned unchanged %+v, expected webhook %d", plan.Unchanged, 4759308)
+	}
+	if len(plan.Delete) != 1 || plan.Delete[0].ID != 4759306 {
+		t.Errorf("Webhook.PlanReconcile returned delete %+v, expected webhook %d", plan.Delete, 4759306)
+	}
+}
+
+func TestWebhookApplyPlanErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		httpmock.NewStringResponder(422, `{"errors":{"address":["for this topic has already been taken"]}}`))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	created := Webhook{Topic: "products/update", Address: "https://example.com/products"}
+	plan := &WebhookPlan{
+		Create: []Webhook{created},
+		Delete: []Webhook{{ID: 4759306, Topic: "orders/create", Address: "http://apple.com"}},
+	}
+	err := client.Webhook.ApplyPlan(plan)
+
+	planErr, ok := err.(WebhookPlanError)
+	if !ok || len(planErr.Errors) != 1 {
+		t.Fatalf("Webhook.ApplyPlan returned error %#v, expected a WebhookPlanError", err)
+	}
+	if planErr.Errors[0].Operation != WebhookOperationCreate || !reflect.DeepEqual(planErr.Errors[0].Webhook, created) {
+		t.Errorf("WebhookPlanError returned %+v", planErr.Errors[0])
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if info["DELETE "+fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix)] != 1 {
+		t.Error("Webhook.ApplyPlan did not delete the webhook after a failed create")
+	}
+}